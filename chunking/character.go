@@ -0,0 +1,28 @@
+package chunking
+
+import (
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+// ByCharacter chunks elements like the basic strategy of [unstructured.ChunkerCharacter]:
+// elements are added to a chunk for as long as they fit in MaxCharacters, regardless of sections or pages,
+// and a new chunk starts once the chunk exceeds NewAfterNChars.
+//
+// Unset settings take the defaults of the API: MaxCharacters is 500 and NewAfterNChars defaults to MaxCharacters.
+func ByCharacter(elements []Element, c unstructured.ChunkerCharacter) ([]Element, error) {
+	o, err := characterOptions(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return chunk(elements, o), nil
+}
+
+func characterOptions(c unstructured.ChunkerCharacter) (options, error) {
+	o, err := newOptions(c.MaxCharacters, c.NewAfterNChars, 0, c.Overlap, c.OverlapAll, c.IncludeOrigElements)
+
+	// chunks are only ever cut short by NewAfterNChars, which combining would undo.
+	o.combineUnderN = 0
+
+	return o, err
+}
@@ -0,0 +1,54 @@
+package chunking
+
+import (
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func TestByCharacter(t *testing.T) {
+	t.Parallel()
+
+	elements := fixture(t)
+
+	for _, c := range []unstructured.ChunkerCharacter{
+		{IncludeOrigElements: true},
+		{IncludeOrigElements: true, MaxCharacters: 1200},
+		{IncludeOrigElements: true, MaxCharacters: 250, Overlap: 20, OverlapAll: true},
+	} {
+		chunks, err := ByCharacter(elements, c)
+		if err != nil {
+			t.Fatalf("failed to chunk with %+v: %v", c, err)
+		}
+
+		maxCharacters := c.MaxCharacters
+		if maxCharacters == 0 {
+			maxCharacters = 500
+		}
+
+		checkChunks(t, elements, chunks, maxCharacters)
+
+		// without sections, a chunk only ends when the next element does not fit in it,
+		// unless it is the end of an element that was split.
+		for i := 0; i+1 < len(chunks); i++ {
+			this, next := chunks[i], chunks[i+1]
+			if this.Type != TypeCompositeElement || next.Type != TypeCompositeElement || this.Metadata.IsContinuation ||
+				next.Metadata.IsContinuation {
+				continue
+			}
+
+			text := normalize(next.Metadata.OrigElements[0].Text)
+			if length(this.Text)+len(separator)+length(text) <= maxCharacters && !c.OverlapAll {
+				t.Errorf("chunk %d ends although %q fits in it", i, text)
+			}
+		}
+	}
+}
+
+func TestByCharacterInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ByCharacter(nil, unstructured.ChunkerCharacter{MaxCharacters: 100, Overlap: 100}); err == nil {
+		t.Error("expected an overlap as long as the chunks to be invalid")
+	}
+}
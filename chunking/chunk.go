@@ -0,0 +1,438 @@
+// Package chunking implements the chunking strategies of the Unstructured API locally,
+// so that chunker settings can be tried on partitioned output that was already downloaded
+// without running a new job.
+//
+// The strategies take the same settings as the chunker nodes of a workflow, like [unstructured.ChunkerTitle],
+// and apply the same semantics as the API to the elements:
+//
+//   - elements are gathered into pre-chunks, which end at the boundaries of the strategy, like titles,
+//     and before they would exceed MaxCharacters or once they exceed NewAfterNChars;
+//   - tables are never combined with other elements;
+//   - each pre-chunk becomes a CompositeElement, with the texts of its elements separated by blank lines;
+//   - pre-chunks that are still longer than MaxCharacters, because of a single long element,
+//     are split at the last line break or space that fits, with Overlap characters repeated at the start of each split,
+//     and the splits after the first are marked as continuations;
+//   - tables that are too long are split into TableChunk elements, by rows of their HTML if they have one;
+//   - with OverlapAll, each chunk also starts with the last Overlap characters of the previous one,
+//     except for tables split by rows.
+//
+// Element IDs of chunks are derived from their position and text, so that chunking is deterministic,
+// and thus differ from the IDs the API assigns.
+package chunking
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// separator joins the texts of the elements of a chunk.
+const separator = "\n\n"
+
+// options are the settings shared by all strategies.
+type options struct {
+	maxCharacters  int
+	newAfterNChars int
+	combineUnderN  int
+	overlap        int
+	overlapAll     bool
+	includeOrig    bool
+
+	// boundary reports whether e starts a new section after prev.
+	boundary func(prev, e *Element) bool
+}
+
+// newOptions applies the defaults of the API to the settings of a chunker node and validates them.
+func newOptions(maxCharacters, newAfterNChars, combineUnderN, overlap int, overlapAll, includeOrig bool) (options, error) {
+	o := options{
+		maxCharacters:  maxCharacters,
+		newAfterNChars: newAfterNChars,
+		combineUnderN:  combineUnderN,
+		overlap:        overlap,
+		overlapAll:     overlapAll,
+		includeOrig:    includeOrig,
+	}
+
+	if o.maxCharacters == 0 {
+		o.maxCharacters = 500
+	}
+
+	if o.newAfterNChars == 0 || o.newAfterNChars > o.maxCharacters {
+		o.newAfterNChars = o.maxCharacters
+	}
+
+	if o.combineUnderN == 0 {
+		o.combineUnderN = o.maxCharacters
+	}
+
+	switch {
+	case o.maxCharacters < 0 || o.newAfterNChars < 0 || o.combineUnderN < 0 || o.overlap < 0:
+		return o, errors.New("chunking settings must not be negative")
+	case o.combineUnderN > o.maxCharacters:
+		return o, fmt.Errorf("combine text under %d characters exceeds max characters %d", o.combineUnderN, o.maxCharacters)
+	case o.overlap >= o.maxCharacters:
+		return o, fmt.Errorf("overlap %d must be less than max characters %d", o.overlap, o.maxCharacters)
+	}
+
+	return o, nil
+}
+
+// interOverlap is how many characters of each chunk are repeated at the start of the next.
+func (o options) interOverlap() int {
+	if o.overlapAll {
+		return o.overlap
+	}
+
+	return 0
+}
+
+// preChunk is a run of elements that will become one or more chunks.
+type preChunk struct {
+	elements []Element
+	prefix   string
+	table    bool
+}
+
+func (p *preChunk) text() string {
+	if p.table {
+		text := normalize(p.elements[0].Text)
+		if p.prefix == "" {
+			return text
+		}
+
+		return p.prefix + " " + text
+	}
+
+	segments := make([]string, 0, len(p.elements)+1)
+	if p.prefix != "" {
+		segments = append(segments, p.prefix)
+	}
+
+	for _, e := range p.elements {
+		if text := normalize(e.Text); text != "" {
+			segments = append(segments, text)
+		}
+	}
+
+	return strings.Join(segments, separator)
+}
+
+// hasText reports whether any element of the pre-chunk has text.
+func (p *preChunk) hasText() bool {
+	for _, e := range p.elements {
+		if normalize(e.Text) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tail is the end of the pre-chunk's text that the next chunk starts with, if chunks overlap.
+func (p *preChunk) tail(o options) string {
+	n := o.interOverlap()
+	if n == 0 {
+		return ""
+	}
+
+	text := []rune(p.text())
+
+	return strings.TrimSpace(string(text[max(len(text)-n, 0):]))
+}
+
+// chunk splits elements into chunks.
+func chunk(elements []Element, o options) []Element {
+	var chunks []Element
+
+	for _, p := range combine(preChunks(elements, o), o) {
+		if p.table {
+			chunks = append(chunks, tableChunks(p, o)...)
+		} else {
+			chunks = append(chunks, textChunks(p, o)...)
+		}
+	}
+
+	for i := range chunks {
+		sum := sha256.Sum256([]byte(strconv.Itoa(i) + "\x00" + chunks[i].Text))
+		chunks[i].ElementID = hex.EncodeToString(sum[:16])
+	}
+
+	return chunks
+}
+
+// preChunks gathers the elements into pre-chunks at the boundaries of the strategy and before they grow too long.
+func preChunks(elements []Element, o options) []*preChunk {
+	var (
+		out     []*preChunk
+		current = &preChunk{}
+		prev    *Element
+	)
+
+	flush := func() {
+		if len(current.elements) == 0 {
+			return
+		}
+
+		out = append(out, current)
+		current = &preChunk{prefix: current.tail(o)}
+	}
+
+	for i := range elements {
+		e := &elements[i]
+		if e.Type == TypePageBreak {
+			continue
+		}
+
+		if prev != nil && o.boundary != nil && o.boundary(prev, e) {
+			flush()
+		}
+
+		prev = e
+
+		// elements without text, like empty images, stay with the elements before them.
+		if e.Type != TypeTable && normalize(e.Text) == "" && !current.table {
+			current.elements = append(current.elements, *e)
+			continue
+		}
+
+		if !fits(current, e, o) {
+			flush()
+		}
+
+		current.elements = append(current.elements, *e)
+		current.table = e.Type == TypeTable
+	}
+
+	flush()
+
+	return out
+}
+
+// fits reports whether e may be added to the pre-chunk p.
+func fits(p *preChunk, e *Element, o options) bool {
+	if len(p.elements) == 0 {
+		return true
+	}
+
+	// tables are never combined with other elements.
+	if p.table || e.Type == TypeTable {
+		return false
+	}
+
+	n := length(p.text())
+	if n > o.newAfterNChars {
+		return false
+	}
+
+	return n+len(separator)+length(normalize(e.Text)) <= o.maxCharacters
+}
+
+// combine merges consecutive pre-chunks while they are shorter than CombineTextUnderN characters
+// and the result fits in MaxCharacters. Strategies that never combine set combineUnderN to zero.
+func combine(pres []*preChunk, o options) []*preChunk {
+	var out []*preChunk
+
+	for _, p := range pres {
+		if len(out) == 0 || p.table || out[len(out)-1].table {
+			out = append(out, p)
+			continue
+		}
+
+		last := out[len(out)-1]
+
+		if length(last.text()) >= o.combineUnderN {
+			out = append(out, p)
+			continue
+		}
+
+		combined := &preChunk{
+			elements: append(last.elements[:len(last.elements):len(last.elements)], p.elements...),
+			prefix:   last.prefix,
+		}
+		if length(combined.text()) > o.maxCharacters {
+			out = append(out, p)
+			continue
+		}
+
+		out[len(out)-1] = combined
+	}
+
+	return out
+}
+
+// textChunks turns a pre-chunk into composite elements, splitting its text if it is too long.
+func textChunks(p *preChunk, o options) []Element {
+	metadata := consolidate(p.elements)
+	if o.includeOrig {
+		metadata.OrigElements = p.elements
+	}
+
+	// elements without text, like empty images, do not make a chunk of their own.
+	if !p.hasText() {
+		return nil
+	}
+
+	var chunks []Element
+
+	for i, text := range split(p.text(), o.maxCharacters, o.overlap) {
+		m := metadata
+		m.IsContinuation = i > 0
+
+		chunks = append(chunks, Element{Type: TypeCompositeElement, Text: text, Metadata: m})
+	}
+
+	return chunks
+}
+
+var tableRow = regexp.MustCompile(`(?is)<tr[\s>].*?</tr>`)
+
+// tableChunks returns a table as is if it fits, and splits it into table chunks otherwise,
+// by groups of rows of its HTML if it has one, or else like text.
+func tableChunks(p *preChunk, o options) []Element {
+	table := p.elements[0]
+	text := p.text()
+
+	metadata := consolidate(p.elements)
+	if o.includeOrig {
+		metadata.OrigElements = p.elements
+	}
+
+	if length(text) <= o.maxCharacters {
+		metadata.TextAsHTML = table.Metadata.TextAsHTML
+		return []Element{{Type: TypeTable, Text: text, Metadata: metadata}}
+	}
+
+	type part struct{ text, html string }
+
+	var parts []part
+
+	rows := tableRow.FindAllString(table.Metadata.TextAsHTML, -1)
+
+	if len(rows) == 0 {
+		for _, s := range split(text, o.maxCharacters, o.overlap) {
+			parts = append(parts, part{text: s})
+		}
+	} else {
+		var (
+			texts    []string
+			rowsHTML strings.Builder
+		)
+
+		flush := func() {
+			if len(texts) > 0 {
+				parts = append(parts, part{strings.Join(texts, " "), "<table>" + rowsHTML.String() + "</table>"})
+				texts = nil
+
+				rowsHTML.Reset()
+			}
+		}
+
+		for _, row := range rows {
+			rowText := normalize(html.UnescapeString(htmlTag.ReplaceAllString(row, " ")))
+
+			if length(rowText) > o.maxCharacters {
+				flush()
+
+				for _, s := range split(rowText, o.maxCharacters, o.overlap) {
+					parts = append(parts, part{text: s})
+				}
+
+				continue
+			}
+
+			if len(texts) > 0 && length(strings.Join(texts, " "))+1+length(rowText) > o.maxCharacters {
+				flush()
+			}
+
+			texts = append(texts, rowText)
+			rowsHTML.WriteString(row)
+		}
+
+		flush()
+	}
+
+	chunks := make([]Element, len(parts))
+
+	for i, part := range parts {
+		m := metadata
+		m.TextAsHTML = part.html
+		m.IsContinuation = i > 0
+
+		chunks[i] = Element{Type: TypeTableChunk, Text: part.text, Metadata: m}
+	}
+
+	return chunks
+}
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// split splits text into pieces of at most maxLen characters,
+// at the last line break that fits, or else at the last space that fits, or else at maxLen.
+// Each piece after the first starts with the last overlap characters of the piece before it.
+func split(text string, maxLen, overlap int) []string {
+	r := []rune(text)
+
+	var pieces []string
+
+	for len(r) > maxLen {
+		// a separator right after maxLen characters still leaves a piece that fits.
+		window := r[:maxLen+1]
+
+		cut, next := -1, 0
+
+		for _, isSep := range []func(rune) bool{
+			func(c rune) bool { return c == '\n' },
+			unicode.IsSpace,
+		} {
+			for i := len(window) - 1; i > 0; i-- {
+				if isSep(window[i]) {
+					cut, next = i, i+1
+					break
+				}
+			}
+
+			// the piece must be longer than the overlap, so that the next one starts further on.
+			if cut > 0 && length(strings.TrimSpace(string(r[:cut]))) > overlap {
+				break
+			}
+
+			cut = -1
+		}
+
+		if cut < 0 {
+			cut, next = maxLen, maxLen
+		}
+
+		piece := strings.TrimRightFunc(string(r[:cut]), unicode.IsSpace)
+		pieces = append(pieces, piece)
+
+		if overlap > 0 {
+			next = max(utf8.RuneCountInString(piece)-overlap, 1)
+		}
+
+		r = []rune(strings.TrimLeftFunc(string(r[next:]), unicode.IsSpace))
+	}
+
+	if len(r) > 0 || len(pieces) == 0 {
+		pieces = append(pieces, string(r))
+	}
+
+	return pieces
+}
+
+// normalize collapses runs of whitespace in the text of an element into single spaces, like the API does.
+func normalize(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// length counts characters the way the API does, in code points.
+func length(s string) int {
+	return utf8.RuneCountInString(s)
+}
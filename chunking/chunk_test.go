@@ -0,0 +1,133 @@
+package chunking
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// fixture returns the elements of the partitioned fixture PDF.
+func fixture(t *testing.T) []Element {
+	t.Helper()
+
+	f, err := os.Open("../test/testdata/170603762v7-841f6504.pdf.json")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %v", err)
+	}
+
+	t.Cleanup(func() { _ = f.Close() })
+
+	elements, err := ReadElements(f)
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	return elements
+}
+
+func TestSplit(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		text    string
+		max     int
+		overlap int
+		want    []string
+	}{
+		{"short", 10, 0, []string{"short"}},
+		{"one two three four", 10, 0, []string{"one two", "three four"}},
+		{"one two three four", 9, 0, []string{"one two", "three", "four"}},
+		{"first\n\nsecond part here", 16, 0, []string{"first", "second part here"}},
+		{"abcdefghijklmnop", 6, 0, []string{"abcdef", "ghijkl", "mnop"}},
+		{"one two three four", 10, 3, []string{"one two", "two three", "ree four"}},
+		{"ééé ééé", 4, 0, []string{"ééé", "ééé"}},
+	} {
+		if got := split(tt.text, tt.max, tt.overlap); !slices.Equal(got, tt.want) {
+			t.Errorf("split(%q, %d, %d): expected %q, got %q", tt.text, tt.max, tt.overlap, tt.want, got)
+		}
+	}
+}
+
+func TestOptions(t *testing.T) {
+	t.Parallel()
+
+	o, err := newOptions(0, 0, 0, 0, false, false)
+	if err != nil {
+		t.Fatalf("failed to apply defaults: %v", err)
+	}
+
+	if o.maxCharacters != 500 || o.newAfterNChars != 500 || o.combineUnderN != 500 {
+		t.Errorf("expected defaults of 500 characters, got %+v", o)
+	}
+
+	for _, args := range [][4]int{{-1, 0, 0, 0}, {100, 0, 200, 0}, {100, 0, 0, 100}} {
+		if _, err := newOptions(args[0], args[1], args[2], args[3], false, false); err == nil {
+			t.Errorf("expected settings %v to be invalid", args)
+		}
+	}
+}
+
+// checkChunks verifies what every strategy guarantees: chunks fit, tables stay apart,
+// and the original elements of the chunks are the input, in order.
+func checkChunks(t *testing.T, elements, chunks []Element, maxCharacters int) {
+	t.Helper()
+
+	var orig []string
+
+	for i, c := range chunks {
+		if n := length(c.Text); n > maxCharacters || n == 0 {
+			t.Errorf("chunk %d has %d characters, expected 1 to %d", i, n, maxCharacters)
+		}
+
+		switch c.Type {
+		case TypeCompositeElement:
+		case TypeTable, TypeTableChunk:
+			if len(c.Metadata.OrigElements) != 1 || c.Metadata.OrigElements[0].Type != TypeTable {
+				t.Errorf("chunk %d is a %s of %d elements, expected a single table", i, c.Type, len(c.Metadata.OrigElements))
+			}
+		default:
+			t.Errorf("chunk %d has unexpected type %s", i, c.Type)
+		}
+
+		// split chunks share their original elements.
+		if i > 0 && c.Metadata.OrigElements[0].ElementID == chunks[i-1].Metadata.OrigElements[0].ElementID {
+			continue
+		}
+
+		split := i+1 < len(chunks) && chunks[i+1].Metadata.OrigElements[0].ElementID == c.Metadata.OrigElements[0].ElementID
+
+		for _, e := range c.Metadata.OrigElements {
+			orig = append(orig, e.ElementID)
+
+			if text := normalize(e.Text); c.Type == TypeCompositeElement && !split && !strings.Contains(c.Text, text) {
+				t.Errorf("chunk %d does not contain the text of its element %s", i, e.ElementID)
+			}
+		}
+	}
+
+	var want []string
+
+	for _, e := range elements {
+		if e.Type != TypePageBreak {
+			want = append(want, e.ElementID)
+		}
+	}
+
+	// elements without text are only dropped if nothing else is in their chunk.
+	if len(orig) > len(want) || !isSubsequence(orig, want) {
+		t.Errorf("expected the original elements of the chunks to be the input in order")
+	}
+}
+
+func isSubsequence(sub, seq []string) bool {
+	i := 0
+
+	for _, s := range seq {
+		if i < len(sub) && sub[i] == s {
+			i++
+		}
+	}
+
+	return i == len(sub)
+}
@@ -0,0 +1,203 @@
+package chunking
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Element types produced by chunking.
+const (
+	TypeCompositeElement = "CompositeElement"
+	TypeTable            = "Table"
+	TypeTableChunk       = "TableChunk"
+	TypeTitle            = "Title"
+	TypePageBreak        = "PageBreak"
+)
+
+// Element is a document element, as output by a partitioner or a chunker.
+type Element struct {
+	Type      string   `json:"type"`
+	ElementID string   `json:"element_id"`
+	Text      string   `json:"text"`
+	Metadata  Metadata `json:"metadata"`
+}
+
+// Metadata is the metadata of an element.
+// The fields that chunking reads or writes are decoded, and any others are kept in Extra.
+type Metadata struct {
+	PageNumber     int       `json:"page_number,omitempty"`
+	Languages      []string  `json:"languages,omitempty"`
+	ParentID       string    `json:"parent_id,omitempty"`
+	CategoryDepth  *int      `json:"category_depth,omitempty"`
+	TextAsHTML     string    `json:"text_as_html,omitempty"`
+	IsContinuation bool      `json:"is_continuation,omitempty"`
+	OrigElements   []Element `json:"-"`
+
+	// Extra holds the metadata fields that are not decoded, like filename and data_source.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// ReadElements decodes the elements of a JSON document as output by a job, see [unstructured.Client.DownloadJob].
+func ReadElements(r io.Reader) ([]Element, error) {
+	var elements []Element
+	if err := json.NewDecoder(r).Decode(&elements); err != nil {
+		return nil, fmt.Errorf("failed to decode elements: %w", err)
+	}
+
+	return elements, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Original elements are encoded like the API does, as base64 encoded gzipped JSON.
+func (m Metadata) MarshalJSON() ([]byte, error) {
+	type alias Metadata
+
+	known, err := json.Marshal(alias(m))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	fields := make(map[string]json.RawMessage, len(m.Extra)+8)
+	for k, v := range m.Extra {
+		fields[k] = v
+	}
+
+	if err := json.Unmarshal(known, &fields); err != nil {
+		return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	if len(m.OrigElements) > 0 {
+		orig, err := encodeElements(m.OrigElements)
+		if err != nil {
+			return nil, err
+		}
+
+		fields["orig_elements"], _ = json.Marshal(orig)
+	}
+
+	return json.Marshal(fields) //nolint:wrapcheck
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *Metadata) UnmarshalJSON(data []byte) error {
+	type alias Metadata
+
+	var known alias
+	if err := json.Unmarshal(data, &known); err != nil {
+		return fmt.Errorf("failed to unmarshal metadata: %w", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("failed to unmarshal metadata: %w", err)
+	}
+
+	*m = Metadata(known)
+
+	if raw, ok := fields["orig_elements"]; ok {
+		var orig string
+		if err := json.Unmarshal(raw, &orig); err != nil {
+			return fmt.Errorf("failed to unmarshal orig_elements: %w", err)
+		}
+
+		elements, err := decodeElements(orig)
+		if err != nil {
+			return err
+		}
+
+		m.OrigElements = elements
+	}
+
+	for _, k := range []string{
+		"page_number", "languages", "parent_id", "category_depth", "text_as_html", "is_continuation", "orig_elements",
+	} {
+		delete(fields, k)
+	}
+
+	if len(fields) > 0 {
+		m.Extra = fields
+	}
+
+	return nil
+}
+
+func encodeElements(elements []Element) (string, error) {
+	data, err := json.Marshal(elements)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal orig_elements: %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	zw := gzip.NewWriter(&buf)
+	_, _ = zw.Write(data)
+
+	if err := zw.Close(); err != nil {
+		return "", fmt.Errorf("failed to compress orig_elements: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func decodeElements(s string) ([]Element, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode orig_elements: %w", err)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress orig_elements: %w", err)
+	}
+
+	elements, err := ReadElements(zr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read orig_elements: %w", err)
+	}
+
+	return elements, nil
+}
+
+// dropped lists the metadata fields that describe a single element, which chunks do not inherit.
+var dropped = []string{
+	"coordinates", "detection_class_prob", "detection_origin", "element_id",
+	"emphasized_text_contents", "emphasized_text_tags", "image_base64", "image_mime_type", "image_path", "image_url",
+	"key_value_pairs", "link_start_indexes", "link_texts", "link_urls", "links",
+}
+
+// consolidate returns the metadata of a chunk of the given elements: the first value of each field,
+// the languages of all of them, and none of the fields that describe a single element.
+func consolidate(elements []Element) Metadata {
+	var m Metadata
+
+	for _, e := range elements {
+		if m.PageNumber == 0 {
+			m.PageNumber = e.Metadata.PageNumber
+		}
+
+		for _, lang := range e.Metadata.Languages {
+			if !slices.Contains(m.Languages, lang) {
+				m.Languages = append(m.Languages, lang)
+			}
+		}
+
+		for k, v := range e.Metadata.Extra {
+			if _, ok := m.Extra[k]; ok || slices.Contains(dropped, k) {
+				continue
+			}
+
+			if m.Extra == nil {
+				m.Extra = make(map[string]json.RawMessage)
+			}
+
+			m.Extra[k] = v
+		}
+	}
+
+	return m
+}
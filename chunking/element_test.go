@@ -0,0 +1,59 @@
+package chunking
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func TestElementJSON(t *testing.T) {
+	t.Parallel()
+
+	chunks, err := ByTitle(fixture(t)[:20], unstructured.ChunkerTitle{IncludeOrigElements: true})
+	if err != nil {
+		t.Fatalf("failed to chunk: %v", err)
+	}
+
+	data, err := json.Marshal(chunks)
+	if err != nil {
+		t.Fatalf("failed to marshal chunks: %v", err)
+	}
+
+	var raw []struct {
+		Metadata map[string]any `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("failed to unmarshal chunks: %v", err)
+	}
+
+	// orig_elements is encoded like the API encodes it, and other metadata is kept.
+	if _, ok := raw[0].Metadata["orig_elements"].(string); !ok {
+		t.Errorf("expected orig_elements to be a string, got %T", raw[0].Metadata["orig_elements"])
+	}
+
+	if raw[0].Metadata["filename"] != "170603762v7-841f6504.pdf" {
+		t.Errorf("expected the filename to be kept, got %v", raw[0].Metadata["filename"])
+	}
+
+	if _, ok := raw[0].Metadata["parent_id"]; ok {
+		t.Error("expected parent_id not to be inherited by chunks")
+	}
+
+	decoded, err := ReadElements(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to read chunks: %v", err)
+	}
+
+	if len(decoded) != len(chunks) {
+		t.Fatalf("expected %d chunks, got %d", len(chunks), len(decoded))
+	}
+
+	for i := range chunks {
+		got, want := decoded[i].Metadata.OrigElements, chunks[i].Metadata.OrigElements
+		if len(got) != len(want) || got[0].ElementID != want[0].ElementID || got[0].Text != want[0].Text {
+			t.Errorf("expected chunk %d to round trip its original elements", i)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package chunking
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+// Evaluation measures how a chunker node chunks a set of documents.
+type Evaluation struct {
+	// Node is the chunker node that was evaluated, as given to [Evaluate].
+	Node unstructured.WorkflowNode `json:"-"`
+
+	// Err is why the node could not be evaluated, like [ErrUnsupported], in which case the measures are zero.
+	Err error `json:"-"`
+
+	// Stats describes the chunks of all documents.
+	Stats Stats `json:"stats"`
+
+	// LengthVariance is the variance of the lengths of the chunks, in characters squared.
+	LengthVariance float64 `json:"length_variance"`
+
+	// Titles counts the Title elements with text, and TitleAlignment is the share of them that start a chunk.
+	// Titles in the middle of a chunk mean that sections were merged or cut.
+	Titles         int     `json:"titles"`
+	TitleAlignment float64 `json:"title_alignment"`
+
+	// Tables counts the tables, and SplitTables the ones that were split across more than one chunk.
+	Tables      int `json:"tables"`
+	SplitTables int `json:"split_tables"`
+
+	// Redundancy is the share of the characters of the chunks that repeat text of the chunk before them, because of overlap.
+	Redundancy float64 `json:"redundancy"`
+
+	// Score rates the chunks from 0 to 1, higher being better. It is one minus the mean of five penalties,
+	// each between 0 and 1: the coefficient of variation of the lengths, capped at 1, the share of titles
+	// that do not start a chunk, the share of tables that were split, the redundancy,
+	// and the share of chunks that were cut at MaxCharacters.
+	Score float64 `json:"score"`
+}
+
+// Evaluate chunks the documents, each a list of partitioned elements, with every chunker node of the grid,
+// and measures the results. Evaluations are in the order of the grid.
+// A node that cannot be evaluated, like [unstructured.ChunkerSimilarity], which fails with [ErrUnsupported],
+// has the error in the Err of its evaluation, and the other nodes are still evaluated.
+func Evaluate(documents [][]Element, grid []unstructured.WorkflowNode) []Evaluation {
+	evaluations := make([]Evaluation, len(grid))
+
+	for i, node := range grid {
+		o, err := nodeOptions(node)
+		if err != nil {
+			evaluations[i] = Evaluation{Node: node, Err: fmt.Errorf("failed to evaluate node %d: %w", i, err)}
+			continue
+		}
+
+		// metrics are measured on the original elements of the chunks.
+		o.includeOrig = true
+
+		evaluations[i] = evaluate(documents, node, o)
+	}
+
+	return evaluations
+}
+
+// Recommend returns the evaluation with the best score, favoring the first of equal ones,
+// or nil if there are none without an error. Its Node can be used as is in the WorkflowNodes of an [unstructured.CreateWorkflowRequest].
+func Recommend(evaluations []Evaluation) *Evaluation {
+	var best *Evaluation
+
+	for i := range evaluations {
+		if evaluations[i].Err == nil && (best == nil || evaluations[i].Score > best.Score) {
+			best = &evaluations[i]
+		}
+	}
+
+	return best
+}
+
+func evaluate(documents [][]Element, node unstructured.WorkflowNode, o options) Evaluation {
+	e := Evaluation{Node: node}
+
+	var (
+		all               []Element
+		aligned, repeated int
+	)
+
+	for _, elements := range documents {
+		chunks := chunk(elements, o)
+		all = append(all, chunks...)
+
+		for _, el := range elements {
+			switch {
+			case el.Type == TypeTitle && normalize(el.Text) != "":
+				e.Titles++
+			case el.Type == TypeTable:
+				e.Tables++
+			}
+		}
+
+		for _, group := range groups(chunks) {
+			first := group[0]
+
+			switch first.Type {
+			case TypeTableChunk:
+				e.SplitTables++
+			case TypeCompositeElement:
+				// the text of the elements with its separators is what the group would hold without overlap.
+				var (
+					texts []string
+					lead  string
+				)
+
+				for _, el := range first.Metadata.OrigElements {
+					if text := normalize(el.Text); text != "" {
+						texts = append(texts, text)
+
+						if lead == "" {
+							lead = el.Type
+						}
+					}
+				}
+
+				n := 0
+				for _, c := range group {
+					n += length(c.Text)
+				}
+
+				repeated += max(n-length(strings.Join(texts, separator)), 0)
+
+				if lead == TypeTitle {
+					aligned++
+				}
+			}
+		}
+	}
+
+	e.Stats = NewStats(all, o.maxCharacters)
+
+	total := 0
+
+	for _, c := range all {
+		d := float64(length(c.Text)) - e.Stats.MeanLength
+		e.LengthVariance += d * d
+		total += length(c.Text)
+	}
+
+	var cv, misaligned, split, cut float64
+
+	if len(all) > 0 {
+		e.LengthVariance /= float64(len(all))
+		cut = float64(e.Stats.AtLimit) / float64(len(all))
+	}
+
+	if e.Stats.MeanLength > 0 {
+		cv = min(math.Sqrt(e.LengthVariance)/e.Stats.MeanLength, 1)
+	}
+
+	if total > 0 {
+		e.Redundancy = float64(repeated) / float64(total)
+	}
+
+	if e.Titles > 0 {
+		e.TitleAlignment = float64(min(aligned, e.Titles)) / float64(e.Titles)
+		misaligned = 1 - e.TitleAlignment
+	}
+
+	if e.Tables > 0 {
+		split = float64(e.SplitTables) / float64(e.Tables)
+	}
+
+	e.Score = 1 - (cv+misaligned+split+e.Redundancy+cut)/5
+
+	return e
+}
+
+// groups gathers the chunks made from the same pre-chunk, which share their original elements.
+func groups(chunks []Element) [][]Element {
+	var out [][]Element
+
+	for i, c := range chunks {
+		if i > 0 && c.Metadata.IsContinuation {
+			out[len(out)-1] = append(out[len(out)-1], c)
+			continue
+		}
+
+		out = append(out, []Element{c})
+	}
+
+	return out
+}
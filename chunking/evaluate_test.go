@@ -0,0 +1,104 @@
+package chunking
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	elements := fixture(t)
+
+	grid := []unstructured.WorkflowNode{
+		&unstructured.ChunkerTitle{Name: "Chunker"},
+		&unstructured.ChunkerTitle{Name: "Chunker", Overlap: 50, OverlapAll: true},
+		&unstructured.ChunkerCharacter{Name: "Chunker"},
+		&unstructured.ChunkerCharacter{Name: "Chunker", MaxCharacters: 200},
+		unstructured.ChunkerPage{Name: "Chunker", MaxCharacters: 2000},
+	}
+
+	evaluations := Evaluate([][]Element{elements, elements[:40]}, grid)
+
+	if len(evaluations) != len(grid) {
+		t.Fatalf("expected %d evaluations, got %d", len(grid), len(evaluations))
+	}
+
+	title, overlap, character, small, page := evaluations[0], evaluations[1], evaluations[2], evaluations[3], evaluations[4]
+
+	chunks, _ := ByTitle(elements, unstructured.ChunkerTitle{})
+	more, _ := ByTitle(elements[:40], unstructured.ChunkerTitle{})
+
+	if err := errors.Join(
+		eq("node", title.Node, grid[0]),
+		eq("chunks", title.Stats.Chunks, len(chunks)+len(more)),
+		eq("tables", title.Tables, 4),
+		eq("split tables", title.SplitTables, 2),
+		eq("page split tables", page.SplitTables, 0),
+		eq("redundancy", title.Redundancy, 0.0),
+		eq("overlap redundancy", overlap.Redundancy > 0, true),
+		eq("title alignment", title.TitleAlignment > character.TitleAlignment, true),
+		eq("small chunks cut", small.Stats.AtLimit > character.Stats.AtLimit, true),
+	); err != nil {
+		t.Error(err)
+	}
+
+	for i, e := range evaluations {
+		if e.Score < 0 || e.Score > 1 || e.TitleAlignment < 0 || e.TitleAlignment > 1 || e.LengthVariance <= 0 {
+			t.Errorf("evaluation %d is out of range: %+v", i, e)
+		}
+	}
+
+	best := Recommend(evaluations)
+	if best == nil {
+		t.Fatal("expected a recommendation")
+	}
+
+	for _, e := range evaluations {
+		if e.Score > best.Score {
+			t.Errorf("expected the best score %f to be the highest, got %f", best.Score, e.Score)
+		}
+	}
+
+	// the recommendation plugs into a workflow.
+	data, err := json.Marshal(&unstructured.CreateWorkflowRequest{
+		Name:          "ingest",
+		WorkflowNodes: []unstructured.WorkflowNode{&unstructured.PartitionerFast{Name: "Partitioner"}, best.Node},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal workflow: %v", err)
+	}
+
+	if !strings.Contains(string(data), `"type":"chunk"`) {
+		t.Errorf("expected the workflow to have a chunker, got %s", data)
+	}
+
+	if Recommend(nil) != nil {
+		t.Error("expected no recommendation without evaluations")
+	}
+}
+
+func TestEvaluateUnsupported(t *testing.T) {
+	t.Parallel()
+
+	evaluations := Evaluate([][]Element{fixture(t)}, []unstructured.WorkflowNode{
+		&unstructured.ChunkerSimilarity{},
+		&unstructured.ChunkerTitle{},
+	})
+
+	if !errors.Is(evaluations[0].Err, ErrUnsupported) {
+		t.Errorf("expected similarity chunking to be unsupported, got %v", evaluations[0].Err)
+	}
+
+	if err := errors.Join(
+		eq("title error", evaluations[1].Err, nil),
+		eq("title chunks", evaluations[1].Stats.Chunks > 0, true),
+		eq("recommended", Recommend(evaluations) == &evaluations[1], true),
+	); err != nil {
+		t.Error(err)
+	}
+}
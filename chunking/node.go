@@ -0,0 +1,43 @@
+package chunking
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+// ErrUnsupported is returned for workflow nodes whose strategy cannot be applied locally.
+// This is the case of [unstructured.ChunkerSimilarity], which needs the embeddings of the API to find its boundaries,
+// and of any node that is not a chunker.
+var ErrUnsupported = errors.New("chunking strategy is not supported locally")
+
+// Chunk chunks elements with the strategy and settings of the given chunker node,
+// like [ByTitle], [ByCharacter] or [ByPage] do.
+func Chunk(elements []Element, node unstructured.WorkflowNode) ([]Element, error) {
+	o, err := nodeOptions(node)
+	if err != nil {
+		return nil, err
+	}
+
+	return chunk(elements, o), nil
+}
+
+func nodeOptions(node unstructured.WorkflowNode) (options, error) {
+	switch n := node.(type) {
+	case *unstructured.ChunkerTitle:
+		return titleOptions(*n)
+	case unstructured.ChunkerTitle:
+		return titleOptions(n)
+	case *unstructured.ChunkerCharacter:
+		return characterOptions(*n)
+	case unstructured.ChunkerCharacter:
+		return characterOptions(n)
+	case *unstructured.ChunkerPage:
+		return pageOptions(*n)
+	case unstructured.ChunkerPage:
+		return pageOptions(n)
+	}
+
+	return options{}, fmt.Errorf("%T: %w", node, ErrUnsupported)
+}
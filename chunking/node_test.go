@@ -0,0 +1,50 @@
+package chunking
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func TestChunk(t *testing.T) {
+	t.Parallel()
+
+	elements := fixture(t)
+	text := func(e Element) string { return e.Text }
+
+	want, err := ByPage(elements, unstructured.ChunkerPage{MaxCharacters: 300})
+	if err != nil {
+		t.Fatalf("failed to chunk by page: %v", err)
+	}
+
+	for _, node := range []unstructured.WorkflowNode{
+		&unstructured.ChunkerPage{MaxCharacters: 300},
+		unstructured.ChunkerPage{MaxCharacters: 300},
+	} {
+		got, err := Chunk(elements, node)
+		if err != nil {
+			t.Fatalf("failed to chunk with %T: %v", node, err)
+		}
+
+		if !slices.Equal(mapSlice(got, text), mapSlice(want, text)) {
+			t.Errorf("expected %T to chunk like ByPage", node)
+		}
+	}
+
+	for _, node := range []unstructured.WorkflowNode{&unstructured.ChunkerSimilarity{}, &unstructured.PartitionerFast{}} {
+		if _, err := Chunk(elements, node); !errors.Is(err, ErrUnsupported) {
+			t.Errorf("expected %T to be unsupported, got %v", node, err)
+		}
+	}
+}
+
+func mapSlice[T, U any](s []T, fn func(T) U) []U {
+	out := make([]U, len(s))
+	for i, v := range s {
+		out[i] = fn(v)
+	}
+
+	return out
+}
@@ -0,0 +1,28 @@
+package chunking
+
+import (
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+// ByPage chunks elements like the by_page strategy of [unstructured.ChunkerPage]:
+// every page starts a new chunk, as told by the page_number of the elements, and chunks never span pages.
+// Within a page, elements are added to a chunk like [ByCharacter] does.
+//
+// Unset settings take the defaults of the API: MaxCharacters is 500 and NewAfterNChars defaults to MaxCharacters.
+func ByPage(elements []Element, c unstructured.ChunkerPage) ([]Element, error) {
+	o, err := pageOptions(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return chunk(elements, o), nil
+}
+
+func pageOptions(c unstructured.ChunkerPage) (options, error) {
+	o, err := newOptions(c.MaxCharacters, c.NewAfterNChars, 0, c.Overlap, c.OverlapAll, c.IncludeOrigElements)
+
+	o.combineUnderN = 0
+	o.boundary = func(prev, e *Element) bool { return prev.Metadata.PageNumber != e.Metadata.PageNumber }
+
+	return o, err
+}
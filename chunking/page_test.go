@@ -0,0 +1,67 @@
+package chunking
+
+import (
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func TestByPage(t *testing.T) {
+	t.Parallel()
+
+	elements := fixture(t)
+
+	pages := map[int]bool{}
+
+	for _, e := range elements {
+		if e.Type != TypePageBreak && normalize(e.Text) != "" {
+			pages[e.Metadata.PageNumber] = true
+		}
+	}
+
+	chunks, err := ByPage(elements, unstructured.ChunkerPage{IncludeOrigElements: true})
+	if err != nil {
+		t.Fatalf("failed to chunk: %v", err)
+	}
+
+	checkChunks(t, elements, chunks, 500)
+
+	seen := map[int]bool{}
+
+	for i, c := range chunks {
+		for _, e := range c.Metadata.OrigElements {
+			if e.Metadata.PageNumber != c.Metadata.PageNumber {
+				t.Errorf("chunk %d of page %d has an element of page %d", i, c.Metadata.PageNumber, e.Metadata.PageNumber)
+			}
+		}
+
+		// a new page always starts a new chunk.
+		if i > 0 && c.Metadata.PageNumber != chunks[i-1].Metadata.PageNumber && seen[c.Metadata.PageNumber] {
+			t.Errorf("chunk %d goes back to page %d", i, c.Metadata.PageNumber)
+		}
+
+		seen[c.Metadata.PageNumber] = true
+	}
+
+	if len(seen) != len(pages) {
+		t.Errorf("expected chunks for %d pages, got %d", len(pages), len(seen))
+	}
+
+	// pages never combine, however short they are.
+	large, err := ByPage(elements, unstructured.ChunkerPage{MaxCharacters: 100000})
+	if err != nil {
+		t.Fatalf("failed to chunk: %v", err)
+	}
+
+	tables := 0
+
+	for _, c := range large {
+		if c.Type == TypeTable {
+			tables++
+		}
+	}
+
+	if len(large) < len(pages) || len(large) > len(pages)+2*tables {
+		t.Errorf("expected about one chunk per page, got %d chunks for %d pages and %d tables", len(large), len(pages), tables)
+	}
+}
@@ -0,0 +1,70 @@
+package chunking
+
+// Stats describes the chunks produced by a strategy, to compare the settings of chunkers.
+type Stats struct {
+	Chunks int `json:"chunks"`
+
+	// MinLength, MaxLength and MeanLength describe the lengths of the chunks, in characters.
+	MinLength  int     `json:"min_length"`
+	MaxLength  int     `json:"max_length"`
+	MeanLength float64 `json:"mean_length"`
+
+	// Histogram counts the chunks by length, in ten buckets of equal width up to MaxCharacters,
+	// or in one bucket per length if MaxCharacters is less than ten.
+	Histogram []Bucket `json:"histogram"`
+
+	// AtLimit counts the chunks that were cut at MaxCharacters, because an element or a table
+	// was too long to fit in one chunk.
+	AtLimit int `json:"at_limit"`
+}
+
+// Bucket counts the chunks whose length is between Min and Max characters, inclusive.
+type Bucket struct {
+	Min   int `json:"min"`
+	Max   int `json:"max"`
+	Count int `json:"count"`
+}
+
+// NewStats computes the stats of chunks produced with the given MaxCharacters, or its default of 500 if it is zero.
+func NewStats(chunks []Element, maxCharacters int) Stats {
+	if maxCharacters <= 0 {
+		maxCharacters = 500
+	}
+
+	buckets := min(10, maxCharacters)
+	width := (maxCharacters + buckets - 1) / buckets
+
+	s := Stats{Chunks: len(chunks), Histogram: make([]Bucket, buckets)}
+
+	for i := range s.Histogram {
+		s.Histogram[i] = Bucket{Min: i*width + 1, Max: min((i+1)*width, maxCharacters)}
+	}
+
+	s.Histogram[0].Min = 0
+
+	total := 0
+
+	for i, c := range chunks {
+		n := length(c.Text)
+		total += n
+
+		if i == 0 || n < s.MinLength {
+			s.MinLength = n
+		}
+
+		s.MaxLength = max(s.MaxLength, n)
+
+		s.Histogram[min(max(n-1, 0)/width, buckets-1)].Count++
+
+		// a chunk was cut if the next one continues it.
+		if i+1 < len(chunks) && chunks[i+1].Metadata.IsContinuation {
+			s.AtLimit++
+		}
+	}
+
+	if len(chunks) > 0 {
+		s.MeanLength = float64(total) / float64(len(chunks))
+	}
+
+	return s
+}
@@ -0,0 +1,72 @@
+package chunking
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func TestNewStats(t *testing.T) {
+	t.Parallel()
+
+	text := func(n int, continuation bool) Element {
+		return Element{Text: strings.Repeat("x", n), Metadata: Metadata{IsContinuation: continuation}}
+	}
+
+	s := NewStats([]Element{text(100, false), text(100, true), text(5, false), text(1, false), text(100, false)}, 100)
+
+	want := make([]Bucket, 10)
+	for i := range want {
+		want[i] = Bucket{Min: i*10 + 1, Max: (i + 1) * 10}
+	}
+
+	want[0] = Bucket{Min: 0, Max: 10, Count: 2}
+	want[9].Count = 3
+
+	if err := errors.Join(
+		eq("chunks", s.Chunks, 5),
+		eq("min length", s.MinLength, 1),
+		eq("max length", s.MaxLength, 100),
+		eq("mean length", s.MeanLength, 61.2),
+		eq("at limit", s.AtLimit, 1),
+		eq("histogram", fmt.Sprint(s.Histogram), fmt.Sprint(want)),
+	); err != nil {
+		t.Error(err)
+	}
+
+	if s := NewStats(nil, 4); s.Chunks != 0 || len(s.Histogram) != 4 || s.Histogram[3] != (Bucket{4, 4, 0}) {
+		t.Errorf("expected no chunks and a bucket per length, got %+v", s)
+	}
+}
+
+func TestNewStatsFixture(t *testing.T) {
+	t.Parallel()
+
+	chunks, err := ByCharacter(fixture(t), unstructured.ChunkerCharacter{MaxCharacters: 200})
+	if err != nil {
+		t.Fatalf("failed to chunk: %v", err)
+	}
+
+	s := NewStats(chunks, 200)
+
+	total := 0
+	for _, b := range s.Histogram {
+		total += b.Count
+	}
+
+	// the fixture has paragraphs and tables longer than 200 characters.
+	if total != len(chunks) || s.MaxLength > 200 || s.AtLimit == 0 {
+		t.Errorf("expected every chunk in the histogram, none over 200 characters and some at the limit, got %+v", s)
+	}
+}
+
+func eq[T comparable](name string, got, want T) error {
+	if got != want {
+		return fmt.Errorf("%s: expected %v, got %v", name, want, got)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package chunking
+
+import (
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+// ByTitle chunks elements like the chunk_by_title strategy of [unstructured.ChunkerTitle]:
+// every Title element starts a new section, and sections that are shorter than CombineTextUnderN characters
+// are combined with the sections after them as long as they fit in MaxCharacters.
+// Sections may span pages.
+//
+// Unset settings take the defaults of the API: MaxCharacters is 500,
+// and NewAfterNChars and CombineTextUnderN default to MaxCharacters.
+func ByTitle(elements []Element, c unstructured.ChunkerTitle) ([]Element, error) {
+	o, err := titleOptions(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return chunk(elements, o), nil
+}
+
+func titleOptions(c unstructured.ChunkerTitle) (options, error) {
+	o, err := newOptions(c.MaxCharacters, c.NewAfterNChars, c.CombineTextUnderN, c.Overlap, c.OverlapAll, c.IncludeOrigElements)
+	if err != nil {
+		return o, err
+	}
+
+	o.boundary = func(_, e *Element) bool { return e.Type == TypeTitle }
+
+	return o, nil
+}
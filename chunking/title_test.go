@@ -0,0 +1,205 @@
+package chunking
+
+import (
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func TestByTitle(t *testing.T) {
+	t.Parallel()
+
+	elements := fixture(t)
+
+	for _, c := range []unstructured.ChunkerTitle{
+		{IncludeOrigElements: true},
+		{IncludeOrigElements: true, MaxCharacters: 1500, NewAfterNChars: 1000},
+		{IncludeOrigElements: true, MaxCharacters: 300, CombineTextUnderN: 100, Overlap: 30},
+		{IncludeOrigElements: true, MaxCharacters: 800, Overlap: 40, OverlapAll: true},
+	} {
+		chunks, err := ByTitle(elements, c)
+		if err != nil {
+			t.Fatalf("failed to chunk with %+v: %v", c, err)
+		}
+
+		maxCharacters := c.MaxCharacters
+		if maxCharacters == 0 {
+			maxCharacters = 500
+		}
+
+		checkChunks(t, elements, chunks, maxCharacters)
+	}
+}
+
+// golden is the output of the chunk_by_title chunker of the API for the fixture PDF, with the default settings
+// and original elements included. It is written by the TestChunkByTitle integration test in the test package
+// when it runs against the API.
+const golden = "../test/testdata/chunk_by_title.json"
+
+func TestByTitleGolden(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.Open(golden)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Skip("skipping because the API output is not recorded; run TestChunkByTitle in the test package with UNSTRUCTURED_API_KEY set")
+	} else if err != nil {
+		t.Fatalf("failed to open golden file: %v", err)
+	}
+
+	t.Cleanup(func() { _ = f.Close() })
+
+	want, err := ReadElements(f)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+
+	got, err := ByTitle(origElements(want), unstructured.ChunkerTitle{IncludeOrigElements: true})
+	if err != nil {
+		t.Fatalf("failed to chunk: %v", err)
+	}
+
+	if len(got) != len(want) {
+		t.Errorf("expected %d chunks, got %d", len(want), len(got))
+	}
+
+	for i := range min(len(got), len(want)) {
+		if got[i].Type != want[i].Type || got[i].Text != want[i].Text {
+			t.Errorf("chunk %d: expected %s %q, got %s %q", i, want[i].Type, want[i].Text, got[i].Type, got[i].Text)
+		}
+	}
+}
+
+// origElements returns the elements the chunks were made from, once each, even if they were split across chunks.
+func origElements(chunks []Element) []Element {
+	var elements []Element
+
+	for _, c := range chunks {
+		if len(c.Metadata.OrigElements) == 0 || slices.ContainsFunc(elements, func(e Element) bool {
+			return e.ElementID == c.Metadata.OrigElements[0].ElementID
+		}) {
+			continue
+		}
+
+		elements = append(elements, c.Metadata.OrigElements...)
+	}
+
+	return elements
+}
+
+func TestByTitleSections(t *testing.T) {
+	t.Parallel()
+
+	elements := fixture(t)
+
+	// combining only pre-chunks without text keeps every section apart.
+	chunks, err := ByTitle(elements, unstructured.ChunkerTitle{CombineTextUnderN: 1, IncludeOrigElements: true})
+	if err != nil {
+		t.Fatalf("failed to chunk: %v", err)
+	}
+
+	titles := 0
+
+	for _, e := range elements {
+		if e.Type == TypeTitle {
+			titles++
+		}
+	}
+
+	starts := 0
+
+	for _, c := range chunks {
+		for j, e := range c.Metadata.OrigElements {
+			if e.Type == TypeTitle && j > 0 && normalize(c.Metadata.OrigElements[j-1].Text) != "" {
+				t.Errorf("title %q is not at the start of its chunk", e.Text)
+			}
+		}
+
+		if c.Metadata.OrigElements[0].Type == TypeTitle || hasLeadingTitle(c) {
+			starts++
+		}
+	}
+
+	if starts < titles {
+		t.Errorf("expected %d chunks to start with a title, got %d", titles, starts)
+	}
+}
+
+// hasLeadingTitle reports whether the first element of the chunk with text is a title.
+func hasLeadingTitle(c Element) bool {
+	for _, e := range c.Metadata.OrigElements {
+		if normalize(e.Text) != "" {
+			return e.Type == TypeTitle && strings.HasPrefix(c.Text, normalize(e.Text))
+		}
+	}
+
+	return false
+}
+
+func TestByTitleOverlap(t *testing.T) {
+	t.Parallel()
+
+	chunks, err := ByTitle(fixture(t), unstructured.ChunkerTitle{MaxCharacters: 400, Overlap: 25, OverlapAll: true})
+	if err != nil {
+		t.Fatalf("failed to chunk: %v", err)
+	}
+
+	for i := 1; i < len(chunks); i++ {
+		// tables split by rows do not overlap.
+		if chunks[i-1].Type == TypeTableChunk || chunks[i].Type == TypeTableChunk {
+			continue
+		}
+
+		prev := []rune(chunks[i-1].Text)
+		tail := strings.TrimSpace(string(prev[max(len(prev)-25, 0):]))
+
+		if !strings.HasPrefix(chunks[i].Text, tail) {
+			t.Errorf("chunk %d does not start with the end of chunk %d, %q", i, i-1, tail)
+		}
+	}
+}
+
+func TestByTitleTables(t *testing.T) {
+	t.Parallel()
+
+	chunks, err := ByTitle(fixture(t), unstructured.ChunkerTitle{IncludeOrigElements: true})
+	if err != nil {
+		t.Fatalf("failed to chunk: %v", err)
+	}
+
+	var tables, tableChunks, continuations int
+
+	for _, c := range chunks {
+		switch c.Type {
+		case TypeTable:
+			tables++
+
+			if c.Metadata.TextAsHTML != c.Metadata.OrigElements[0].Metadata.TextAsHTML {
+				t.Error("expected a table that fits to keep its HTML")
+			}
+		case TypeTableChunk:
+			tableChunks++
+
+			if !strings.HasPrefix(c.Metadata.TextAsHTML, "<table><tr") {
+				t.Errorf("expected a table chunk to have the HTML of its rows, got %.40s", c.Metadata.TextAsHTML)
+			}
+
+			if c.Metadata.IsContinuation {
+				continuations++
+			}
+		}
+	}
+
+	// the fixture has two tables under 500 characters and two over it.
+	if tables != 2 || tableChunks < 4 || continuations != tableChunks-2 {
+		t.Errorf("expected 2 tables and the others split, got %d tables and %d table chunks of which %d continue",
+			tables, tableChunks, continuations)
+	}
+
+	if strings.Contains(chunks[len(chunks)-1].Text, "&amp;") {
+		t.Error("expected HTML entities to be decoded")
+	}
+}
@@ -3,12 +3,13 @@ package unstructured
 import (
 	"cmp"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
+	"time"
 )
 
 // Client represents an HTTP client for interacting with the Unstructured.io API.
@@ -16,6 +17,20 @@ import (
 type Client struct {
 	hc       *http.Client
 	endpoint *url.URL
+	poll     time.Duration
+
+	key        string
+	timeout    time.Duration
+	retry      RetryPolicy
+	rateLimit  RateLimit
+	profile    string
+	configPath string
+
+	tracer Tracer
+	meter  Meter
+
+	logger    *slog.Logger
+	logBodies int
 }
 
 // Option is a function that configures a Client instance.
@@ -43,105 +58,222 @@ func WithEndpoint(endpoint string) Option {
 // This is accomplished using a [http.RoundTripper] that sets the key as the value of the `Unstructured-API-Key` header on all requests.
 func WithKey(key string) Option {
 	return func(c *Client) error {
-		if b, ok := c.hc.Transport.(*bearer); ok {
-			b.key = key
-			return nil
+		c.key = key
+		return nil
+	}
+}
+
+// WithClient returns an Option that sets the HTTP client to use for requests.
+// If no client is provided, the client will default to one that behaves like [http.DefaultClient].
+// The given client is not modified; the API key, timeout, retry and rate limit settings
+// are applied to a copy of it.
+func WithClient(hc *http.Client) Option {
+	return func(c *Client) error {
+		c.hc = hc
+		return nil
+	}
+}
+
+// WithPollInterval returns an Option that sets how often the client polls the API
+// while waiting for a long-running operation, such as a connection check, to finish.
+// Without this option, the client will poll once per second.
+func WithPollInterval(d time.Duration) Option {
+	return func(c *Client) error {
+		if d <= 0 {
+			return fmt.Errorf("poll interval must be positive, got %s", d)
 		}
 
-		c.hc.Transport = &bearer{
-			key: key,
-			rt:  cmp.Or(c.hc.Transport, http.DefaultTransport),
+		c.poll = d
+
+		return nil
+	}
+}
+
+// WithTimeout returns an Option that limits how long a single attempt at a request may take,
+// including reading the response body.
+// Without this option, requests are only limited by their context.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		if d <= 0 {
+			return fmt.Errorf("timeout must be positive, got %s", d)
 		}
 
+		c.timeout = d
+
 		return nil
 	}
 }
 
-// WithClient returns an Option that sets the HTTP client to use for requests.
-// If no client is provided, the client will default to [http.DefaultClient].
-func WithClient(hc *http.Client) Option {
+// WithRetry returns an Option that retries requests that fail with a transient error.
+// See [RetryPolicy] for which requests are retried.
+// Without this option, requests are not retried.
+func WithRetry(p RetryPolicy) Option {
 	return func(c *Client) error {
-		c.hc = hc
+		if err := p.validate(); err != nil {
+			return err
+		}
+
+		c.retry = p
+
+		return nil
+	}
+}
+
+// WithRateLimit returns an Option that limits how many requests per second the client sends.
+// Without this option, requests are not limited.
+func WithRateLimit(l RateLimit) Option {
+	return func(c *Client) error {
+		if err := l.validate(); err != nil {
+			return err
+		}
+
+		c.rateLimit = l
+
+		return nil
+	}
+}
+
+// WithProfile returns an Option that configures the client from the named profile of the config file.
+// The config file is found as described by [LoadConfig].
+// Settings given as options or environment variables take precedence over the profile; see [New].
+func WithProfile(name string) Option {
+	return func(c *Client) error {
+		c.profile = name
+		return nil
+	}
+}
+
+// WithConfigFile returns an Option that sets the path of the config file that profiles are read from,
+// instead of the `UNSTRUCTURED_CONFIG` environment variable or [DefaultConfigPath].
+func WithConfigFile(path string) Option {
+	return func(c *Client) error {
+		c.configPath = path
 		return nil
 	}
 }
 
 // New creates a new Client instance with the provided options.
-// If the `UNSTRUCTURED_API_KEY` environment variable is set, it will be used as the API key for authentication.
-// If the `UNSTRUCTURED_API_URL` environment variable is set to a valid URL, it will be used as the base URL for the Unstructured.io API.
-// If no endpoint option is given via options or environment variables, the endpoint will default to the Unstructured.io platform at `https://platform.unstructuredapp.io/api/v1`.
-// In order to configure the client properly, an API key must be provided via [WithKey] or the `UNSTRUCTURED_API_KEY` environment variable.
+//
+// Each setting is taken from the first of these that provides it:
+//
+//  1. options, such as [WithKey] and [WithEndpoint];
+//  2. the profile selected with [WithProfile], if any;
+//  3. the environment: `UNSTRUCTURED_API_KEY` for the API key and `UNSTRUCTURED_API_URL` for the endpoint,
+//     if it is a valid URL;
+//  4. the profile selected with the `UNSTRUCTURED_PROFILE` environment variable, if any;
+//  5. the defaults.
+//
+// A profile selected explicitly thus never mixes its endpoint with an API key from the environment, or the reverse.
+//
+// If no endpoint is given, the endpoint will default to the Unstructured.io platform at `https://platform.unstructuredapp.io/api/v1`.
+// In order to configure the client properly, an API key must be provided via [WithKey], the `UNSTRUCTURED_API_KEY` environment variable or a profile.
 func New(opts ...Option) (*Client, error) {
-	c := Client{
-		hc: http.DefaultClient,
-		endpoint: &url.URL{
-			Scheme: "https",
-			Host:   "platform.unstructuredapp.io",
-			Path:   "/api/v1",
-		},
+	var c Client
+
+	// apply options
+	for _, opt := range opts {
+		if err := opt(&c); err != nil {
+			return nil, err
+		}
+	}
+
+	// a profile selected explicitly takes precedence over the environment.
+	if c.profile != "" {
+		if err := c.applyProfile(c.profile); err != nil {
+			return nil, err
+		}
 	}
 
 	// attempt to set endpoint from environment variable
-	if v := os.Getenv("UNSTRUCTURED_API_URL"); v != "" {
+	if v := os.Getenv("UNSTRUCTURED_API_URL"); v != "" && c.endpoint == nil {
 		if u, err := url.Parse(v); err == nil {
 			c.endpoint = u
 		}
 	}
 
 	// attempt to set API key from environment variable
-	if v := os.Getenv("UNSTRUCTURED_API_KEY"); v != "" {
-		c.hc.Transport = &bearer{
-			key: v,
-			rt:  cmp.Or(c.hc.Transport, http.DefaultTransport),
+	c.key = cmp.Or(c.key, os.Getenv("UNSTRUCTURED_API_KEY"))
+
+	if name := os.Getenv("UNSTRUCTURED_PROFILE"); c.profile == "" && name != "" {
+		if err := c.applyProfile(name); err != nil {
+			return nil, err
 		}
 	}
 
-	// apply options
-	for _, opt := range opts {
-		if err := opt(&c); err != nil {
-			return nil, err
+	if c.endpoint == nil {
+		c.endpoint = &url.URL{
+			Scheme: "https",
+			Host:   "platform.unstructuredapp.io",
+			Path:   "/api/v1",
 		}
 	}
 
+	c.poll = cmp.Or(c.poll, time.Second)
+
+	// copy the HTTP client, so that wrapping its transport does not affect other users of it.
+	hc := http.Client{}
+	if c.hc != nil {
+		hc = *c.hc
+	}
+
+	hc.Transport = cmp.Or(hc.Transport, http.DefaultTransport)
+
+	if c.key != "" {
+		hc.Transport = &bearer{key: c.key, rt: hc.Transport}
+	}
+
+	if c.logger != nil {
+		hc.Transport = &logger{rt: hc.Transport, log: c.logger, bodies: c.logBodies}
+	}
+
+	if c.timeout > 0 || c.retry.MaxAttempts > 1 || c.rateLimit.RequestsPerSecond > 0 {
+		hc.Transport = newPolicy(hc.Transport, c.timeout, c.retry, c.rateLimit)
+	}
+
+	if c.tracer != nil || c.meter != nil {
+		hc.Transport = &instrument{rt: hc.Transport, base: c.endpoint.Path, tracer: c.tracer, meter: c.meter}
+	}
+
+	c.hc = &hc
+
 	return &c, nil
 }
 
 func (c *Client) do(req *http.Request, out any) error {
-	resp, err := c.hc.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute HTTP request: %w", err)
+		return err
 	}
 
 	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return fmt.Errorf("failed to read response body: %w", err)
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
 		}
+	}
 
-		// Handle 422 validation errors specifically
-		if resp.StatusCode == http.StatusUnprocessableEntity {
-			var validationErr HTTPValidationError
-			if err := json.Unmarshal(body, &validationErr); err == nil {
-				return &APIError{
-					Code: resp.StatusCode,
-					Err:  &validationErr,
-				}
-			}
-		}
+	return nil
+}
 
-		return &APIError{
-			Code: resp.StatusCode,
-			Err:  errors.New(string(body)),
-		}
+// send executes the request and returns the response if it has a success status,
+// or an [*APIError] describing it otherwise.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
 	}
 
-	if out != nil {
-		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		defer func() { _ = resp.Body.Close() }()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
 		}
+
+		return nil, newAPIError(resp, body)
 	}
 
-	return nil
+	return resp, nil
 }
@@ -8,7 +8,7 @@ import (
 	"github.com/aws-gopher/unstructured-sdk-go/test"
 )
 
-func testclient(t *testing.T) (*Client, *test.Mux) {
+func testclient(t *testing.T, opts ...Option) (*Client, *test.Mux) {
 	mux := test.NewMux()
 
 	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -27,11 +27,11 @@ func testclient(t *testing.T) (*Client, *test.Mux) {
 	}))
 	t.Cleanup(server.Close)
 
-	c, err := New(
+	c, err := New(append([]Option{
 		WithClient(server.Client()),
 		WithEndpoint(server.URL),
 		WithKey(test.FakeAPIKey),
-	)
+	}, opts...)...)
 	if err != nil {
 		t.Fatalf("failed to create client: %v", err)
 	}
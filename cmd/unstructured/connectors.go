@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+// createFlags are the flags shared by "sources create" and "destinations create".
+type createFlags struct {
+	name   string
+	uri    string
+	typ    string
+	config string
+}
+
+func (f *createFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.name, "name", "", "connector `name`")
+	fs.StringVar(&f.uri, "uri", "", "connection `URI`, like s3://bucket/prefix/")
+	fs.StringVar(&f.typ, "type", "", "connector `type`, like s3")
+	fs.StringVar(&f.config, "config", "", "`file` with the connector config as JSON, or - for standard input")
+}
+
+func (f *createFlags) validate() error {
+	switch {
+	case f.name == "":
+		return usagef("--name is required")
+	case f.uri != "" && (f.typ != "" || f.config != ""):
+		return usagef("--uri cannot be combined with --type or --config")
+	case f.uri == "" && (f.typ == "" || f.config == ""):
+		return usagef("either --uri, or --type and --config are required")
+	}
+
+	return nil
+}
+
+// decodeConfig reads the config file named by the flags and decodes it into v, a *Source or *Destination,
+// which pick the concrete config type from the connector type.
+func (a *app) decodeConfig(f *createFlags, v any) error {
+	var (
+		config []byte
+		err    error
+	)
+
+	if f.config == "-" {
+		config, err = io.ReadAll(a.stdin)
+	} else {
+		config, err = os.ReadFile(f.config)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+
+	if !json.Valid(config) {
+		return usagef("config %s is not valid JSON", f.config)
+	}
+
+	doc, err := json.Marshal(struct {
+		Type   string          `json:"type"`
+		Config json.RawMessage `json:"config"`
+	}{f.typ, config})
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+
+	if err := json.Unmarshal(doc, v); err != nil {
+		return &usageError{err.Error()}
+	}
+
+	return nil
+}
+
+// deleteResult is the output of the delete commands.
+type deleteResult struct {
+	ID         string   `json:"id"`
+	DryRun     bool     `json:"dry_run"`
+	Deleted    bool     `json:"deleted"`
+	Dependents []string `json:"dependents"`
+	Steps      []string `json:"steps"`
+}
+
+func (a *app) deleteConnector(
+	ctx context.Context,
+	args []string,
+	name string,
+	del func(*unstructured.Client, context.Context, unstructured.SafeDeleteRequest) (*unstructured.SafeDeleteResult, error),
+) error {
+	fs := a.flagSet(name)
+	dependents := fs.String("dependents", "refuse",
+		"what to do with workflows that use the connector: refuse or delete")
+	dryRun := fs.Bool("dry-run", false, "only report what would be done")
+
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	in := unstructured.SafeDeleteRequest{ID: args[0], DryRun: *dryRun}
+
+	switch *dependents {
+	case "refuse":
+		in.OnDependents = unstructured.DependentWorkflowsRefuse
+	case "delete":
+		in.OnDependents = unstructured.DependentWorkflowsDelete
+	default:
+		return usagef("unknown --dependents action %q", *dependents)
+	}
+
+	res, err := del(a.client, ctx, in)
+	if err != nil {
+		return err
+	}
+
+	out := deleteResult{
+		ID:         res.ConnectorID,
+		DryRun:     res.DryRun,
+		Deleted:    res.Deleted,
+		Dependents: make([]string, len(res.Dependents)),
+		Steps:      res.Steps,
+	}
+
+	for i, wf := range res.Dependents {
+		out.Dependents[i] = wf.ID
+	}
+
+	return a.print(out, func() table {
+		t := table{header: []string{"STEP"}}
+		for _, step := range res.Steps {
+			t.add(step)
+		}
+
+		return t
+	})
+}
+
+// checkResult is the output of the check commands.
+type checkResult struct {
+	ID          string `json:"id"`
+	ConnectorID string `json:"connector_id"`
+	Status      string `json:"status"`
+	Reason      string `json:"reason,omitempty"`
+	ReportedAt  string `json:"reported_at,omitempty"`
+}
+
+func (a *app) checkConnector(
+	ctx context.Context,
+	args []string,
+	name string,
+	check func(*unstructured.Client, context.Context, string) (*unstructured.ConnectionCheckResult, error),
+) error {
+	fs := a.flagSet(name)
+
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	res, err := check(a.client, ctx, args[0])
+	if res == nil {
+		return err
+	}
+
+	out := checkResult{
+		ID:          res.ID,
+		ConnectorID: res.ConnectorID,
+		Status:      string(res.Status),
+		Reason:      unstructured.ToString(res.Reason),
+		ReportedAt:  timestamp(res.ReportedAt),
+	}
+
+	if perr := a.print(out, func() table {
+		t := table{header: []string{"CONNECTOR", "STATUS", "REASON"}}
+		t.add(out.ConnectorID, out.Status, out.Reason)
+
+		return t
+	}); perr != nil {
+		return perr
+	}
+
+	// a failed check is reported through the exit code, after printing the result.
+	return err
+}
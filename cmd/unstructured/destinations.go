@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func destinationsTable(destinations ...unstructured.Destination) func() table {
+	return func() table {
+		t := table{header: []string{"ID", "NAME", "TYPE", "CREATED", "UPDATED"}}
+		for _, d := range destinations {
+			var typ string
+			if d.Config != nil {
+				typ = d.Config.Type()
+			}
+
+			t.add(d.ID, d.Name, typ, timestamp(d.CreatedAt), timestamp(d.UpdatedAt))
+		}
+
+		return t
+	}
+}
+
+func (a *app) destinationsList(ctx context.Context, args []string) error {
+	fs := a.flagSet("destinations list")
+	typ := fs.String("type", "", "only list destinations of this connector `type`")
+
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	destinations, err := a.client.ListDestinations(ctx, *typ)
+	if err != nil {
+		return err
+	}
+
+	return a.print(destinations, destinationsTable(destinations...))
+}
+
+func (a *app) destinationsGet(ctx context.Context, args []string) error {
+	args, err := a.parse(a.flagSet("destinations get"), args, 1)
+	if err != nil {
+		return err
+	}
+
+	destination, err := a.client.GetDestination(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	return a.print(destination, destinationsTable(*destination))
+}
+
+func (a *app) destinationsCreate(ctx context.Context, args []string) error {
+	var f createFlags
+
+	fs := a.flagSet("destinations create")
+	f.register(fs)
+
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	if err := f.validate(); err != nil {
+		return err
+	}
+
+	var config unstructured.DestinationConfig
+
+	if f.uri != "" {
+		var err error
+		if config, err = unstructured.ParseDestinationURI(f.uri); err != nil {
+			return &usageError{err.Error()}
+		}
+	} else {
+		var d unstructured.Destination
+		if err := a.decodeConfig(&f, &d); err != nil {
+			return err
+		}
+
+		config = d.Config
+	}
+
+	destination, err := a.client.CreateDestination(ctx, unstructured.CreateDestinationRequest{
+		Name:   f.name,
+		Config: config,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.print(destination, destinationsTable(*destination))
+}
+
+func (a *app) destinationsDelete(ctx context.Context, args []string) error {
+	return a.deleteConnector(ctx, args, "destinations delete", (*unstructured.Client).SafeDeleteDestination)
+}
+
+func (a *app) destinationsCheck(ctx context.Context, args []string) error {
+	return a.checkConnector(ctx, args, "destinations check", (*unstructured.Client).CheckDestinationConnection)
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func jobsTable(jobs ...unstructured.Job) func() table {
+	return func() table {
+		t := table{header: []string{"ID", "WORKFLOW", "STATUS", "TYPE", "CREATED", "RUNTIME"}}
+		for _, j := range jobs {
+			t.add(j.ID, j.WorkflowName, string(j.Status), string(j.JobType),
+				timestamp(j.CreatedAt), unstructured.ToString(j.Runtime))
+		}
+
+		return t
+	}
+}
+
+func (a *app) jobsList(ctx context.Context, args []string) error {
+	fs := a.flagSet("jobs list")
+	workflow := fs.String("workflow", "", "only list jobs of this workflow `ID`")
+	status := fs.String("status", "", "only list jobs with this `status`, like IN_PROGRESS")
+
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	in := unstructured.ListJobsRequest{WorkflowID: optional(*workflow)}
+
+	if *status != "" {
+		s := unstructured.JobStatus(*status)
+		in.Status = &s
+	}
+
+	jobs, err := a.client.ListJobs(ctx, &in)
+	if err != nil {
+		return err
+	}
+
+	return a.print(jobs, jobsTable(jobs...))
+}
+
+func (a *app) jobsGet(ctx context.Context, args []string) error {
+	args, err := a.parse(a.flagSet("jobs get"), args, 1)
+	if err != nil {
+		return err
+	}
+
+	job, err := a.client.GetJob(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	return a.print(job, jobsTable(*job))
+}
+
+func (a *app) jobsCancel(ctx context.Context, args []string) error {
+	args, err := a.parse(a.flagSet("jobs cancel"), args, 1)
+	if err != nil {
+		return err
+	}
+
+	if err := a.client.CancelJob(ctx, args[0]); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.stderr, "canceled job %s\n", args[0])
+
+	return nil
+}
+
+func (a *app) jobsDownload(ctx context.Context, args []string) error {
+	fs := a.flagSet("jobs download")
+	node := fs.String("node", "", "`ID` of the node whose output to download")
+	file := fs.String("file", "", "`ID` of the output file to download")
+	out := fs.String("out", "-", "`path` to write the output to, or - for standard output")
+
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	in := unstructured.DownloadJobRequest{JobID: args[0], NodeID: *node, FileID: *file}
+
+	// without both IDs, pick the only output file of the job that matches the ones given.
+	if in.NodeID == "" || in.FileID == "" {
+		job, err := a.client.GetJob(ctx, in.JobID)
+		if err != nil {
+			return err
+		}
+
+		var matches []unstructured.NodeFileMetadata
+
+		for _, f := range job.OutputNodeFiles {
+			if (in.NodeID == "" || f.NodeID == in.NodeID) && (in.FileID == "" || f.FileID == in.FileID) {
+				matches = append(matches, f)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return &failedError{fmt.Sprintf("job %s has no matching output files", in.JobID)}
+		case 1:
+			in.NodeID, in.FileID = matches[0].NodeID, matches[0].FileID
+		default:
+			return usagef("job %s has %d matching output files, choose one with --node and --file", in.JobID, len(matches))
+		}
+	}
+
+	body, err := a.client.DownloadJob(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	defer body.Close()
+
+	if *out == "-" {
+		_, err = io.Copy(a.stdout, body)
+	} else {
+		err = writeFile(*out, body)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to download job output: %w", err)
+	}
+
+	return nil
+}
+
+func writeFile(path string, r io.Reader) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err //nolint:wrapcheck
+	}
+
+	return f.Close() //nolint:wrapcheck
+}
+
+func (a *app) jobsFailedFiles(ctx context.Context, args []string) error {
+	args, err := a.parse(a.flagSet("jobs failed-files"), args, 1)
+	if err != nil {
+		return err
+	}
+
+	failed, err := a.client.GetJobFailedFiles(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	return a.print(failed, func() table {
+		t := table{header: []string{"DOCUMENT", "ERROR"}}
+		for _, f := range failed.FailedFiles {
+			t.add(f.Document, f.Error)
+		}
+
+		return t
+	})
+}
+
+// waitJob polls the job every interval until it is no longer scheduled or in progress,
+// reporting each status change on standard error.
+func (a *app) waitJob(ctx context.Context, job *unstructured.Job, interval time.Duration) (*unstructured.Job, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	fmt.Fprintf(a.stderr, "job %s: %s\n", job.ID, job.Status)
+
+	for job.Status == unstructured.JobStatusScheduled || job.Status == unstructured.JobStatusInProgress {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to wait for job %s: %w", job.ID, ctx.Err())
+		case <-ticker.C:
+		}
+
+		next, err := a.client.GetJob(ctx, job.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if next.Status != job.Status {
+			fmt.Fprintf(a.stderr, "job %s: %s\n", next.ID, next.Status)
+		}
+
+		job = next
+	}
+
+	return job, nil
+}
+
+// jobResult returns a [*failedError] if the job finished without completing.
+func jobResult(job *unstructured.Job) error {
+	switch job.Status {
+	case unstructured.JobStatusFailed, unstructured.JobStatusStopped:
+		return &failedError{fmt.Sprintf("job %s finished with status %s", job.ID, job.Status)}
+	}
+
+	return nil
+}
@@ -0,0 +1,403 @@
+// Command unstructured is a command line client for the Unstructured.io Workflow Endpoint API.
+//
+// It manages sources, destinations, workflows and jobs:
+//
+//	unstructured sources list
+//	unstructured sources create --name docs --uri s3://bucket/docs/
+//	unstructured workflows run --file report.pdf --wait 6f3c...
+//	unstructured jobs watch 1d2e...
+//	unstructured jobs failed-files -o yaml 1d2e...
+//
+// Like [unstructured.New], it reads the API key from UNSTRUCTURED_API_KEY and,
+// if set, the endpoint from UNSTRUCTURED_API_URL. Other accounts can be selected
+// with --profile or UNSTRUCTURED_PROFILE, from the config file described by [unstructured.LoadConfig].
+//
+// Results are printed as a table, or as JSON or YAML with -o json and -o yaml.
+//
+// # Exit codes
+//
+//	0    success
+//	1    unexpected error, such as a network failure
+//	2    invalid command line
+//	3    missing or rejected API key
+//	4    resource not found
+//	5    request rejected by the API as invalid
+//	6    a job or connection check finished unsuccessfully
+//	7    a connector cannot be deleted because workflows use it
+//	130  interrupted
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+// Exit codes, as documented in the package comment.
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitUsage       = 2
+	exitAuth        = 3
+	exitNotFound    = 4
+	exitInvalid     = 5
+	exitFailed      = 6
+	exitInUse       = 7
+	exitInterrupted = 130
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+
+	a := app{
+		stdin:  os.Stdin,
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+		getenv: os.Getenv,
+	}
+
+	code := a.run(ctx, os.Args[1:])
+
+	stop()
+	os.Exit(code)
+}
+
+// app holds the state of a single invocation of the command.
+type app struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(string) string
+
+	// opts are passed to [unstructured.New] after the environment has been read.
+	opts []unstructured.Option
+
+	format  string
+	profile string
+	client  *unstructured.Client
+}
+
+// group is a resource, like "sources", and the commands that act on it.
+type group struct {
+	name     string
+	summary  string
+	commands []command
+}
+
+// command is a single action on a resource, like "sources list".
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(a *app, ctx context.Context, args []string) error
+}
+
+var groups = []group{
+	{
+		name:    "sources",
+		summary: "manage source connectors",
+		commands: []command{
+			{"list", "[--type TYPE]", "list source connectors", (*app).sourcesList},
+			{"get", "ID", "show a source connector", (*app).sourcesGet},
+			{"create", "--name NAME (--uri URI | --type TYPE --config FILE)", "create a source connector", (*app).sourcesCreate},
+			{"delete", "ID", "delete a source connector", (*app).sourcesDelete},
+			{"check", "ID", "test the connection of a source connector", (*app).sourcesCheck},
+		},
+	},
+	{
+		name:    "destinations",
+		summary: "manage destination connectors",
+		commands: []command{
+			{"list", "[--type TYPE]", "list destination connectors", (*app).destinationsList},
+			{"get", "ID", "show a destination connector", (*app).destinationsGet},
+			{"create", "--name NAME (--uri URI | --type TYPE --config FILE)", "create a destination connector", (*app).destinationsCreate},
+			{"delete", "ID", "delete a destination connector", (*app).destinationsDelete},
+			{"check", "ID", "test the connection of a destination connector", (*app).destinationsCheck},
+		},
+	},
+	{
+		name:    "workflows",
+		summary: "manage and run workflows",
+		commands: []command{
+			{"list", "[--status STATUS] [--source ID] [--destination ID] [--name NAME]", "list workflows", (*app).workflowsList},
+			{"get", "ID", "show a workflow", (*app).workflowsGet},
+			{"run", "[--file PATH]... [--wait] ID", "run a workflow", (*app).workflowsRun},
+			{"delete", "ID", "delete a workflow", (*app).workflowsDelete},
+		},
+	},
+	{
+		name:    "jobs",
+		summary: "inspect and control jobs",
+		commands: []command{
+			{"list", "[--workflow ID] [--status STATUS]", "list jobs", (*app).jobsList},
+			{"get", "ID", "show a job", (*app).jobsGet},
+			{"watch", "[--interval DURATION] [--plain] ID", "follow the progress of a job", (*app).jobsWatch},
+			{"cancel", "ID", "cancel a job", (*app).jobsCancel},
+			{"download", "[--node ID] [--file ID] [--out PATH] ID", "download an output file of a job", (*app).jobsDownload},
+			{"failed-files", "ID", "list the files a job failed to process", (*app).jobsFailedFiles},
+		},
+	},
+}
+
+// run executes the command line args and returns the process exit code.
+func (a *app) run(ctx context.Context, args []string) int {
+	fs := a.flagSet("unstructured")
+	fs.Usage = func() { a.usage(nil) }
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitUsage
+	}
+
+	args = fs.Args()
+	if len(args) == 0 {
+		a.usage(nil)
+		return exitUsage
+	}
+
+	if args[0] == "help" {
+		a.usage(nil)
+		return exitOK
+	}
+
+	g := findGroup(args[0])
+	if g == nil {
+		fmt.Fprintf(a.stderr, "unstructured: unknown resource %q\n\n", args[0])
+		a.usage(nil)
+
+		return exitUsage
+	}
+
+	if len(args) < 2 {
+		a.usage(g)
+		return exitUsage
+	}
+
+	if args[1] == "help" {
+		a.usage(g)
+		return exitOK
+	}
+
+	cmd := g.find(args[1])
+	if cmd == nil {
+		fmt.Fprintf(a.stderr, "unstructured: unknown command %q for %s\n\n", args[1], g.name)
+		a.usage(g)
+
+		return exitUsage
+	}
+
+	err := cmd.run(a, ctx, args[2:])
+	if err == nil || errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+
+	fmt.Fprintf(a.stderr, "unstructured %s %s: %v\n", g.name, cmd.name, err)
+
+	var usage *usageError
+	if errors.As(err, &usage) {
+		fmt.Fprintf(a.stderr, "usage: unstructured %s %s %s\n", g.name, cmd.name, cmd.usage)
+	}
+
+	return exitCode(err)
+}
+
+// connect creates the API client, reading the key and endpoint from the environment the same way [unstructured.New] does.
+func (a *app) connect() error {
+	opts := a.opts
+
+	if a.profile != "" {
+		opts = append(opts, unstructured.WithProfile(a.profile))
+	} else if a.getenv("UNSTRUCTURED_API_KEY") == "" && a.getenv("UNSTRUCTURED_PROFILE") == "" {
+		return errMissingKey
+	}
+
+	c, err := unstructured.New(opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	a.client = c
+
+	return nil
+}
+
+var errMissingKey = errors.New("UNSTRUCTURED_API_KEY is not set and no profile was selected")
+
+func findGroup(name string) *group {
+	for i := range groups {
+		if groups[i].name == name {
+			return &groups[i]
+		}
+	}
+
+	return nil
+}
+
+func (g *group) find(name string) *command {
+	for i := range g.commands {
+		if g.commands[i].name == name {
+			return &g.commands[i]
+		}
+	}
+
+	return nil
+}
+
+// usage prints the resources, or the commands of g if it is not nil.
+func (a *app) usage(g *group) {
+	w := a.stderr
+
+	if g == nil {
+		fmt.Fprintln(w, "usage: unstructured [-o table|json|yaml] [--profile NAME] <resource> <command> [flags] [args]")
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "resources:")
+
+		for _, g := range groups {
+			fmt.Fprintf(w, "  %-14s %s\n", g.name, g.summary)
+		}
+
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "The API key is read from UNSTRUCTURED_API_KEY, and the endpoint from UNSTRUCTURED_API_URL.")
+		fmt.Fprintln(w, "Profiles are read from $UNSTRUCTURED_CONFIG or ~/.config/unstructured/config.yaml.")
+
+		return
+	}
+
+	fmt.Fprintf(w, "usage: unstructured %s <command> [flags] [args]\n", g.name)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+
+	for _, c := range g.commands {
+		fmt.Fprintf(w, "  %-14s %s\n", c.name, c.summary)
+	}
+}
+
+// flagSet returns a flag set that already has the output format and profile flags,
+// so that they can be given both before and after the command name.
+func (a *app) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+
+	if a.format == "" {
+		a.format = formatTable
+	}
+
+	fs.Func("o", "output `format`: table, json or yaml (default table)", func(s string) error {
+		switch s {
+		case formatTable, formatJSON, formatYAML:
+			a.format = s
+			return nil
+		}
+
+		return fmt.Errorf("unknown output format %q", s)
+	})
+
+	fs.StringVar(&a.profile, "profile", a.profile, "`name` of the config file profile to use")
+
+	return fs
+}
+
+// parse parses args with fs, allowing flags to come after positional arguments,
+// checks that exactly n positional arguments were given and then connects to the API.
+func (a *app) parse(fs *flag.FlagSet, args []string, n int) ([]string, error) {
+	var positional []string
+
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+
+			return nil, &usageError{err.Error()}
+		}
+
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if len(positional) != n {
+		return nil, usagef("expected %d arguments, got %d", n, len(positional))
+	}
+
+	if err := a.connect(); err != nil {
+		return nil, err
+	}
+
+	return positional, nil
+}
+
+// usageError is returned for invalid command lines.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string { return e.msg }
+
+func usagef(format string, args ...any) error {
+	return &usageError{fmt.Sprintf(format, args...)}
+}
+
+// failedError is returned when a job or connection check finishes unsuccessfully.
+type failedError struct {
+	msg string
+}
+
+func (e *failedError) Error() string { return e.msg }
+
+// exitCode maps an error returned by a command to the process exit code.
+func exitCode(err error) int {
+	var (
+		usage  *usageError
+		failed *failedError
+		check  *unstructured.ConnectionCheckError
+		inUse  *unstructured.DependentWorkflowsError
+		api    *unstructured.APIError
+	)
+
+	switch {
+	case errors.As(err, &usage):
+		return exitUsage
+	case errors.Is(err, errMissingKey):
+		return exitAuth
+	case errors.Is(err, context.Canceled):
+		return exitInterrupted
+	case errors.As(err, &failed), errors.As(err, &check):
+		return exitFailed
+	case errors.As(err, &inUse):
+		return exitInUse
+	case errors.Is(err, unstructured.ErrUnauthorized), errors.Is(err, unstructured.ErrForbidden):
+		return exitAuth
+	case errors.Is(err, unstructured.ErrNotFound):
+		return exitNotFound
+	case errors.As(err, &api) && (api.Code == http.StatusBadRequest || api.Code == http.StatusUnprocessableEntity):
+		return exitInvalid
+	}
+
+	return exitError
+}
+
+// stringsFlag is a flag that can be given multiple times.
+type stringsFlag []string
+
+func (s *stringsFlag) String() string { return strings.Join(*s, ",") }
+
+func (s *stringsFlag) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
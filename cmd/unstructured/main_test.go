@@ -0,0 +1,325 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/aws-gopher/unstructured-sdk-go/test"
+)
+
+// cli runs the command against a fake server and returns what it printed.
+type cli struct {
+	t    *testing.T
+	fake *test.FakeServer
+	url  string
+	key  string
+}
+
+func newCLI(t *testing.T) *cli {
+	t.Helper()
+
+	fake := test.NewFakeServer()
+
+	// every poll of a job or connection check moves the clock on, so that they make progress.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet &&
+			(strings.HasPrefix(r.URL.Path, "/api/v1/jobs/") || strings.HasSuffix(r.URL.Path, "/connection-check")) {
+			fake.Advance(20 * time.Second)
+		}
+
+		fake.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return &cli{t: t, fake: fake, url: server.URL + "/api/v1", key: test.FakeAPIKey}
+}
+
+func (c *cli) run(stdin string, args ...string) (code int, stdout, stderr string) {
+	c.t.Helper()
+
+	var out, errOut bytes.Buffer
+
+	a := app{
+		stdin:  strings.NewReader(stdin),
+		stdout: &out,
+		stderr: &errOut,
+		getenv: func(name string) string {
+			if name == "UNSTRUCTURED_API_KEY" {
+				return c.key
+			}
+
+			return ""
+		},
+		opts: []unstructured.Option{
+			unstructured.WithClient(&http.Client{}),
+			unstructured.WithEndpoint(c.url),
+			unstructured.WithKey(c.key),
+			unstructured.WithPollInterval(time.Millisecond),
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	code = a.run(ctx, args)
+
+	return code, out.String(), errOut.String()
+}
+
+// workflow creates a workflow without connectors, which can only be run with input files.
+func (c *cli) workflow() *unstructured.Workflow {
+	c.t.Helper()
+
+	client, err := unstructured.New(
+		unstructured.WithClient(&http.Client{}),
+		unstructured.WithEndpoint(c.url),
+		unstructured.WithKey(test.FakeAPIKey),
+	)
+	if err != nil {
+		c.t.Fatalf("failed to create client: %v", err)
+	}
+
+	wf, err := client.CreateWorkflow(context.Background(), &unstructured.CreateWorkflowRequest{
+		Name:          "ingest",
+		WorkflowNodes: []unstructured.WorkflowNode{&unstructured.PartitionerFast{Name: "Partitioner"}},
+	})
+	if err != nil {
+		c.t.Fatalf("failed to create workflow: %v", err)
+	}
+
+	return wf
+}
+
+// mustRun runs the command, fails the test unless it exits with want, and returns its standard output.
+func (c *cli) mustRun(want int, stdin string, args ...string) string {
+	c.t.Helper()
+
+	code, stdout, stderr := c.run(stdin, args...)
+	if code != want {
+		c.t.Fatalf("%s: expected exit code %d, got %d\nstdout:\n%s\nstderr:\n%s",
+			strings.Join(args, " "), want, code, stdout, stderr)
+	}
+
+	return stdout
+}
+
+func TestSources(t *testing.T) {
+	t.Parallel()
+
+	c := newCLI(t)
+
+	var created struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	}
+
+	out := c.mustRun(exitOK, "", "-o", "json", "sources", "create", "--name", "docs", "--uri", "s3://docs/input/")
+	if err := json.Unmarshal([]byte(out), &created); err != nil {
+		t.Fatalf("failed to decode created source: %v\n%s", err, out)
+	}
+
+	if created.ID == "" || created.Type != unstructured.ConnectorTypeS3 {
+		t.Errorf("unexpected created source: %+v", created)
+	}
+
+	out = c.mustRun(exitOK, `{"remote_url": "s3://docs/other/"}`,
+		"sources", "create", "--name", "other", "--type", "s3", "--config", "-")
+	if !strings.Contains(out, "other") {
+		t.Errorf("expected table to show the created source, got:\n%s", out)
+	}
+
+	out = c.mustRun(exitOK, "", "sources", "list")
+	if lines := strings.Split(strings.TrimSpace(out), "\n"); len(lines) != 3 || !strings.HasPrefix(lines[0], "ID") {
+		t.Errorf("expected a header and two sources, got:\n%s", out)
+	}
+
+	out = c.mustRun(exitOK, "", "sources", "get", created.ID, "-o", "yaml")
+	for _, want := range []string{"id: " + created.ID + "\n", "name: docs\n", "  remote_url: \"s3://docs/input/\"\n"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected YAML to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	c.fake.FailConnectionCheck(created.ID, "access denied")
+
+	out = c.mustRun(exitFailed, "", "sources", "check", created.ID)
+	if !strings.Contains(out, "access denied") {
+		t.Errorf("expected failed check to show the reason, got:\n%s", out)
+	}
+
+	c.mustRun(exitOK, "", "sources", "delete", created.ID)
+	c.mustRun(exitNotFound, "", "sources", "get", created.ID)
+}
+
+func TestWorkflowsRun(t *testing.T) {
+	t.Parallel()
+
+	c := newCLI(t)
+
+	wf := c.workflow()
+
+	dir := t.TempDir()
+	input := filepath.Join(dir, "hello.txt")
+
+	if err := os.WriteFile(input, []byte("Hello, world."), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var job struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+	}
+
+	out := c.mustRun(exitOK, "", "workflows", "run", "--file", input, "--wait", "--interval", "1ms", "-o", "json", wf.ID)
+	if err := json.Unmarshal([]byte(out), &job); err != nil {
+		t.Fatalf("failed to decode job: %v\n%s", err, out)
+	}
+
+	if job.Status != string(unstructured.JobStatusCompleted) {
+		t.Errorf("expected waited job to be %s, got %s", unstructured.JobStatusCompleted, job.Status)
+	}
+
+	output := filepath.Join(dir, "hello.json")
+	c.mustRun(exitOK, "", "jobs", "download", job.ID, "--out", output)
+
+	data, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(data), "Hello, world.") {
+		t.Errorf("expected downloaded output to contain the input text, got %s", data)
+	}
+
+	c.fake.FailFile("broken.txt", "unsupported file")
+
+	broken := filepath.Join(dir, "broken.txt")
+	if err := os.WriteFile(broken, []byte("???"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	c.mustRun(exitFailed, "", "workflows", "run", "--file", broken, "--wait", "--interval", "1ms", wf.ID)
+
+	out = c.mustRun(exitOK, "", "jobs", "list", "--workflow", wf.ID)
+	if !strings.Contains(out, "FAILED") || !strings.Contains(out, "COMPLETED") {
+		t.Errorf("expected both jobs to be listed, got:\n%s", out)
+	}
+}
+
+func TestExitCodes(t *testing.T) {
+	t.Parallel()
+
+	c := newCLI(t)
+	wf := c.workflow()
+
+	for _, tc := range []struct {
+		name string
+		key  string
+		args []string
+		want int
+	}{
+		{"no arguments", test.FakeAPIKey, nil, exitUsage},
+		{"help", test.FakeAPIKey, []string{"help"}, exitOK},
+		{"unknown resource", test.FakeAPIKey, []string{"pipelines", "list"}, exitUsage},
+		{"unknown command", test.FakeAPIKey, []string{"jobs", "retry"}, exitUsage},
+		{"missing argument", test.FakeAPIKey, []string{"jobs", "get"}, exitUsage},
+		{"unknown format", test.FakeAPIKey, []string{"-o", "xml", "jobs", "list"}, exitUsage},
+		{"missing key", "", []string{"jobs", "list"}, exitAuth},
+		{"rejected key", "wrong", []string{"jobs", "list"}, exitAuth},
+		{"not found", test.FakeAPIKey, []string{"workflows", "get", "missing"}, exitNotFound},
+		{"invalid request", test.FakeAPIKey, []string{"workflows", "run", wf.ID}, exitInvalid},
+		{"invalid config", test.FakeAPIKey, []string{"sources", "create", "--name", "x", "--type", "s3", "--config", "-"}, exitUsage},
+	} {
+		c.key = tc.key
+
+		if code, stdout, stderr := c.run("{", tc.args...); code != tc.want {
+			t.Errorf("%s: expected exit code %d, got %d\nstdout:\n%s\nstderr:\n%s", tc.name, tc.want, code, stdout, stderr)
+		}
+	}
+}
+
+func TestJobsWatch(t *testing.T) {
+	t.Parallel()
+
+	c := newCLI(t)
+	wf := c.workflow()
+
+	dir := t.TempDir()
+	paths := []string{filepath.Join(dir, "hello.txt"), filepath.Join(dir, "broken.txt")}
+
+	for _, path := range paths {
+		if err := os.WriteFile(path, []byte("Hello, world."), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c.fake.FailFile("broken.txt", "unsupported file")
+
+	var job struct {
+		ID string `json:"id"`
+	}
+
+	out := c.mustRun(exitOK, "", "workflows", "run", "--file", paths[0], "--file", paths[1], "-o", "json", wf.ID)
+	if err := json.Unmarshal([]byte(out), &job); err != nil {
+		t.Fatalf("failed to decode job: %v\n%s", err, out)
+	}
+
+	out = c.mustRun(exitOK, "", "jobs", "watch", "--interval", "1ms", job.ID)
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if strings.Count(out, "failed: broken.txt: unsupported file\n") != 1 ||
+		!strings.HasPrefix(lines[len(lines)-1], "COMPLETED 2/2 files; Partitioner: 0 ready, 0 in progress, 1 succeeded, 1 failed") {
+		t.Errorf("unexpected watch output:\n%s", out)
+	}
+
+	var final struct {
+		Done      bool `json:"done"`
+		Processed int  `json:"processed"`
+	}
+
+	out = c.mustRun(exitOK, "", "jobs", "watch", "-o", "json", job.ID)
+	if err := json.Unmarshal([]byte(out), &final); err != nil || !final.Done || final.Processed != 2 {
+		t.Errorf("expected the final snapshot as JSON, got %+v (%v)\n%s", final, err, out)
+	}
+}
+
+func TestScreen(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	s := screen{w: &buf}
+
+	p := &unstructured.JobProgress{
+		Job: &unstructured.Job{ID: "1", WorkflowName: "ingest", Status: unstructured.JobStatusInProgress},
+		Details: &unstructured.JobDetails{NodeStats: []unstructured.JobNodeDetails{
+			{NodeName: unstructured.String("Partitioner"), Ready: 1, InProgress: 1, Success: 1, Failure: 1},
+		}},
+		Processed: 2,
+		Total:     4,
+		Elapsed:   time.Minute,
+		ETA:       time.Minute,
+	}
+
+	for range 2 {
+		if err := s.render(p); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+	}
+
+	frame := "job 1 (ingest)  IN_PROGRESS  2/4 files  elapsed 1m0s  ETA 1m0s\n" +
+		"  Partitioner  [=======xxxxxxx>>>>>>>.........]  ready 1  in progress 1  success 1  failure 1\n"
+
+	if got, want := buf.String(), frame+"\x1b[2A\x1b[J"+frame; got != want {
+		t.Errorf("unexpected screen output:\ngot:  %q\nwant: %q", got, want)
+	}
+}
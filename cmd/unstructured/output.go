@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Output formats accepted by the -o flag.
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+// table is the tabular rendering of a command's result.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func (t *table) add(row ...string) { t.rows = append(t.rows, row) }
+
+// print writes v in the selected output format.
+// For the table format, v is rendered by tab instead.
+func (a *app) print(v any, tab func() table) error {
+	switch a.format {
+	case formatJSON:
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode JSON: %w", err)
+		}
+
+		_, err = fmt.Fprintf(a.stdout, "%s\n", data)
+
+		return err //nolint:wrapcheck
+
+	case formatYAML:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to encode YAML: %w", err)
+		}
+
+		return writeYAML(a.stdout, data)
+	}
+
+	return writeTable(a.stdout, tab())
+}
+
+func writeTable(w io.Writer, t table) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(tw, strings.Join(t.header, "\t"))
+
+	for _, row := range t.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush() //nolint:wrapcheck
+}
+
+// timestamp formats t for a table cell, leaving the cell empty for the zero time.
+func timestamp(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+// member is a key and value of a JSON object.
+type member struct {
+	key   string
+	value any
+}
+
+// object is a JSON object that keeps the order of its keys,
+// so that YAML output lists fields in the same order as JSON output.
+type object []member
+
+// writeYAML writes the JSON document data to w as YAML.
+func writeYAML(w io.Writer, data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	v, err := decodeOrdered(dec)
+	if err != nil {
+		return fmt.Errorf("failed to encode YAML: %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	switch v := v.(type) {
+	case object, []any:
+		yamlBlock(&buf, v, 0)
+	default:
+		buf.WriteString(yamlScalar(v) + "\n")
+	}
+
+	_, err = w.Write(buf.Bytes())
+
+	return err //nolint:wrapcheck
+}
+
+func decodeOrdered(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		obj := object{}
+
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err //nolint:wrapcheck
+			}
+
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			obj = append(obj, member{key.(string), value})
+		}
+
+		_, err = dec.Token()
+
+		return obj, err //nolint:wrapcheck
+
+	case json.Delim('['):
+		arr := []any{}
+
+		for dec.More() {
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			arr = append(arr, value)
+		}
+
+		_, err = dec.Token()
+
+		return arr, err //nolint:wrapcheck
+	}
+
+	return tok, nil
+}
+
+// yamlBlock writes an object or array as a block, indented by indent spaces.
+func yamlBlock(buf *bytes.Buffer, v any, indent int) {
+	pad := strings.Repeat(" ", indent)
+
+	switch v := v.(type) {
+	case object:
+		if len(v) == 0 {
+			buf.WriteString(pad + "{}\n")
+			return
+		}
+
+		for _, m := range v {
+			buf.WriteString(pad + yamlScalar(m.key) + ":")
+			yamlValue(buf, m.value, indent+2)
+		}
+
+	case []any:
+		if len(v) == 0 {
+			buf.WriteString(pad + "[]\n")
+			return
+		}
+
+		for _, item := range v {
+			switch item.(type) {
+			case object, []any:
+				// write nested blocks compactly, starting on the same line as the dash.
+				var nested bytes.Buffer
+				yamlBlock(&nested, item, indent+2)
+				buf.WriteString(pad + "- " + strings.TrimPrefix(nested.String(), pad+"  "))
+			default:
+				buf.WriteString(pad + "- " + yamlScalar(item) + "\n")
+			}
+		}
+	}
+}
+
+// yamlValue writes v after a key that has already been written.
+func yamlValue(buf *bytes.Buffer, v any, indent int) {
+	switch v := v.(type) {
+	case object:
+		if len(v) == 0 {
+			buf.WriteString(" {}\n")
+			return
+		}
+
+		buf.WriteString("\n")
+		yamlBlock(buf, v, indent)
+
+	case []any:
+		if len(v) == 0 {
+			buf.WriteString(" []\n")
+			return
+		}
+
+		buf.WriteString("\n")
+		yamlBlock(buf, v, indent)
+
+	default:
+		buf.WriteString(" " + yamlScalar(v) + "\n")
+	}
+}
+
+func yamlScalar(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	case string:
+		if yamlPlain(v) {
+			return v
+		}
+
+		// a JSON string is also a valid double-quoted YAML string.
+		quoted, _ := json.Marshal(v)
+
+		return string(quoted)
+	}
+
+	return fmt.Sprint(v)
+}
+
+// yamlPlain reports whether s can be written without quotes and still be read back as the same string.
+func yamlPlain(s string) bool {
+	if s == "" || s != strings.TrimSpace(s) || strings.ContainsAny(s, ":#{}[],&*!|>'\"%@`\\\n\t") {
+		return false
+	}
+
+	if strings.ContainsAny(s[:1], "-?") {
+		return false
+	}
+
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "y", "n", "null", "~":
+		return false
+	}
+
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteYAML(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	doc := `{"name":"docs","config":{"remote_url":"s3://docs/","recursive":true,"fields":[]},` +
+		`"nodes":[{"id":"1","settings":{}},["a","no"]],"reason":null,"count":3,"version":"1.0","note":"a: b"}`
+
+	if err := writeYAML(&buf, []byte(doc)); err != nil {
+		t.Fatalf("failed to write YAML: %v", err)
+	}
+
+	want := `name: docs
+config:
+  remote_url: "s3://docs/"
+  recursive: true
+  fields: []
+nodes:
+  - id: "1"
+    settings: {}
+  - - a
+    - "no"
+reason: null
+count: 3
+version: "1.0"
+note: "a: b"
+`
+
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected YAML:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func sourcesTable(sources ...unstructured.Source) func() table {
+	return func() table {
+		t := table{header: []string{"ID", "NAME", "TYPE", "CREATED", "UPDATED"}}
+		for _, s := range sources {
+			var typ string
+			if s.Config != nil {
+				typ = s.Config.Type()
+			}
+
+			t.add(s.ID, s.Name, typ, timestamp(s.CreatedAt), timestamp(s.UpdatedAt))
+		}
+
+		return t
+	}
+}
+
+func (a *app) sourcesList(ctx context.Context, args []string) error {
+	fs := a.flagSet("sources list")
+	typ := fs.String("type", "", "only list sources of this connector `type`")
+
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	sources, err := a.client.ListSources(ctx, *typ)
+	if err != nil {
+		return err
+	}
+
+	return a.print(sources, sourcesTable(sources...))
+}
+
+func (a *app) sourcesGet(ctx context.Context, args []string) error {
+	args, err := a.parse(a.flagSet("sources get"), args, 1)
+	if err != nil {
+		return err
+	}
+
+	source, err := a.client.GetSource(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	return a.print(source, sourcesTable(*source))
+}
+
+func (a *app) sourcesCreate(ctx context.Context, args []string) error {
+	var f createFlags
+
+	fs := a.flagSet("sources create")
+	f.register(fs)
+
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	if err := f.validate(); err != nil {
+		return err
+	}
+
+	var config unstructured.SourceConfig
+
+	if f.uri != "" {
+		var err error
+		if config, err = unstructured.ParseSourceURI(f.uri); err != nil {
+			return &usageError{err.Error()}
+		}
+	} else {
+		var s unstructured.Source
+		if err := a.decodeConfig(&f, &s); err != nil {
+			return err
+		}
+
+		config = s.Config
+	}
+
+	source, err := a.client.CreateSource(ctx, unstructured.CreateSourceRequest{
+		Name:   f.name,
+		Config: config,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.print(source, sourcesTable(*source))
+}
+
+func (a *app) sourcesDelete(ctx context.Context, args []string) error {
+	return a.deleteConnector(ctx, args, "sources delete", (*unstructured.Client).SafeDeleteSource)
+}
+
+func (a *app) sourcesCheck(ctx context.Context, args []string) error {
+	return a.checkConnector(ctx, args, "sources check", (*unstructured.Client).CheckSourceConnection)
+}
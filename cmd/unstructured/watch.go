@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func (a *app) jobsWatch(ctx context.Context, args []string) error {
+	fs := a.flagSet("jobs watch")
+	plain := fs.Bool("plain", false, "print a line per change instead of redrawing the screen")
+	fs.Func("interval", "how often to poll the job (default 1s)", func(s string) error {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		a.opts = append(a.opts, unstructured.WithPollInterval(d))
+
+		return nil
+	})
+
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	var last *unstructured.JobProgress
+
+	render := func(p *unstructured.JobProgress) error {
+		last = p
+		return nil
+	}
+
+	if a.format == formatTable {
+		if !*plain && isTerminal(a.stdout) && a.getenv("TERM") != "dumb" {
+			render = (&screen{w: a.stdout}).render
+		} else {
+			render = (&lines{w: a.stdout}).render
+		}
+	}
+
+	if err := a.client.WatchJob(ctx, args[0], render); err != nil {
+		return err
+	}
+
+	// other formats print only the final snapshot, which is complete.
+	if last != nil {
+		if err := a.print(last, nil); err != nil {
+			return err
+		}
+
+		return jobResult(last.Job)
+	}
+
+	return nil
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	fi, err := f.Stat()
+
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// screen redraws the progress of a job in place, for terminals.
+type screen struct {
+	w      io.Writer
+	height int
+}
+
+func (s *screen) render(p *unstructured.JobProgress) error {
+	var b strings.Builder
+
+	// move back to the start of the previous frame and clear it.
+	if s.height > 0 {
+		fmt.Fprintf(&b, "\x1b[%dA\x1b[J", s.height)
+	}
+
+	frame := frame(p)
+	b.WriteString(frame)
+
+	s.height = strings.Count(frame, "\n")
+
+	_, err := io.WriteString(s.w, b.String())
+	if err != nil || !p.Done {
+		return err //nolint:wrapcheck
+	}
+
+	return jobResult(p.Job)
+}
+
+// frame renders a snapshot as a header line, a progress bar per node and the failed files.
+func frame(p *unstructured.JobProgress) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "job %s (%s)  %s  %d/%d files  elapsed %s", p.Job.ID, p.Job.WorkflowName,
+		p.Job.Status, p.Processed, p.Total, p.Elapsed.Round(time.Second))
+
+	if p.ETA > 0 {
+		fmt.Fprintf(&b, "  ETA %s", p.ETA.Round(time.Second))
+	}
+
+	b.WriteString("\n")
+
+	width := 0
+	for _, n := range p.Details.NodeStats {
+		width = max(width, len(nodeName(n)))
+	}
+
+	for _, n := range p.Details.NodeStats {
+		fmt.Fprintf(&b, "  %-*s  [%s]  ready %d  in progress %d  success %d  failure %d\n",
+			width, nodeName(n), bar(n, 30), n.Ready, n.InProgress, n.Success, n.Failure)
+	}
+
+	if len(p.FailedFiles) > 0 {
+		fmt.Fprintf(&b, "failed files (%d):\n", len(p.FailedFiles))
+
+		for _, f := range p.FailedFiles {
+			fmt.Fprintf(&b, "  %s: %s\n", f.Document, f.Error)
+		}
+	}
+
+	return b.String()
+}
+
+func nodeName(n unstructured.JobNodeDetails) string {
+	if n.NodeName != nil {
+		return *n.NodeName
+	}
+
+	return strings.Trim(unstructured.ToString(n.NodeType)+"/"+unstructured.ToString(n.NodeSubtype), "/")
+}
+
+// bar draws a progress bar of the given width, with a segment for each state:
+// '=' for success, 'x' for failure, '>' for in progress and '.' for ready.
+func bar(n unstructured.JobNodeDetails, width int) string {
+	total := n.Ready + n.InProgress + n.Success + n.Failure
+	if total == 0 {
+		return strings.Repeat(".", width)
+	}
+
+	var b strings.Builder
+
+	used := 0
+	for _, seg := range []struct {
+		count int
+		char  string
+	}{{n.Success, "="}, {n.Failure, "x"}, {n.InProgress, ">"}} {
+		cells := seg.count * width / total
+		b.WriteString(strings.Repeat(seg.char, cells))
+		used += cells
+	}
+
+	b.WriteString(strings.Repeat(".", width-used))
+
+	return b.String()
+}
+
+// lines prints a line whenever the progress of a job changes, for logs and pipes.
+type lines struct {
+	w    io.Writer
+	last string
+}
+
+func (l *lines) render(p *unstructured.JobProgress) error {
+	for _, f := range p.NewFailedFiles {
+		fmt.Fprintf(l.w, "failed: %s: %s\n", f.Document, f.Error)
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s %d/%d files", p.Job.Status, p.Processed, p.Total)
+
+	for _, n := range p.Details.NodeStats {
+		fmt.Fprintf(&b, "; %s: %d ready, %d in progress, %d succeeded, %d failed",
+			nodeName(n), n.Ready, n.InProgress, n.Success, n.Failure)
+	}
+
+	line := b.String()
+	if line != l.last {
+		l.last = line
+
+		fmt.Fprintf(l.w, "%s elapsed %s", line, p.Elapsed.Round(time.Second))
+
+		if p.ETA > 0 {
+			fmt.Fprintf(l.w, " ETA %s", p.ETA.Round(time.Second))
+		}
+
+		fmt.Fprintln(l.w)
+	}
+
+	if p.Done {
+		return jobResult(p.Job)
+	}
+
+	return nil
+}
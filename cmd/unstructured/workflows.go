@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func workflowsTable(workflows ...unstructured.Workflow) func() table {
+	return func() table {
+		t := table{header: []string{"ID", "NAME", "STATUS", "TYPE", "SOURCES", "DESTINATIONS", "CREATED"}}
+		for _, wf := range workflows {
+			var typ string
+			if wf.WorkflowType != nil {
+				typ = string(*wf.WorkflowType)
+			}
+
+			t.add(wf.ID, wf.Name, string(wf.Status), typ,
+				strings.Join(wf.Sources, ","), strings.Join(wf.Destinations, ","), timestamp(wf.CreatedAt))
+		}
+
+		return t
+	}
+}
+
+func (a *app) workflowsList(ctx context.Context, args []string) error {
+	fs := a.flagSet("workflows list")
+	status := fs.String("status", "", "only list workflows with this `status`: active or inactive")
+	source := fs.String("source", "", "only list workflows that use this source `ID`")
+	destination := fs.String("destination", "", "only list workflows that use this destination `ID`")
+	name := fs.String("name", "", "only list workflows with this `name`")
+
+	if _, err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	in := unstructured.ListWorkflowsRequest{
+		SourceID:      optional(*source),
+		DestinationID: optional(*destination),
+		Name:          optional(*name),
+	}
+
+	if *status != "" {
+		s := unstructured.WorkflowState(*status)
+		in.Status = &s
+	}
+
+	workflows, err := a.client.ListWorkflows(ctx, &in)
+	if err != nil {
+		return err
+	}
+
+	return a.print(workflows, workflowsTable(workflows...))
+}
+
+func (a *app) workflowsGet(ctx context.Context, args []string) error {
+	args, err := a.parse(a.flagSet("workflows get"), args, 1)
+	if err != nil {
+		return err
+	}
+
+	wf, err := a.client.GetWorkflow(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	return a.print(wf, workflowsTable(*wf))
+}
+
+func (a *app) workflowsRun(ctx context.Context, args []string) error {
+	var files stringsFlag
+
+	fs := a.flagSet("workflows run")
+	fs.Var(&files, "file", "input `path` to upload; may be repeated")
+	wait := fs.Bool("wait", false, "wait for the job to finish")
+	interval := fs.Duration("interval", 2*time.Second, "how often to poll the job while waiting")
+	timeout := fs.Duration("timeout", 0, "give up waiting after this long (default no limit)")
+
+	args, err := a.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	in := unstructured.RunWorkflowRequest{ID: args[0]}
+
+	for _, path := range files {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open input file: %w", err)
+		}
+
+		defer f.Close()
+
+		in.InputFiles = append(in.InputFiles, &unstructured.FileBytes{
+			Filename: filepath.Base(path),
+			Bytes:    f,
+		})
+	}
+
+	job, err := a.client.RunWorkflow(ctx, &in)
+	if err != nil {
+		return err
+	}
+
+	if *wait {
+		if *timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, *timeout)
+
+			defer cancel()
+		}
+
+		if job, err = a.waitJob(ctx, job, *interval); err != nil {
+			return err
+		}
+	}
+
+	if err := a.print(job, jobsTable(*job)); err != nil {
+		return err
+	}
+
+	return jobResult(job)
+}
+
+func (a *app) workflowsDelete(ctx context.Context, args []string) error {
+	args, err := a.parse(a.flagSet("workflows delete"), args, 1)
+	if err != nil {
+		return err
+	}
+
+	if err := a.client.DeleteWorkflow(ctx, args[0]); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.stderr, "deleted workflow %s\n", args[0])
+
+	return nil
+}
+
+// optional returns a pointer to s, or nil if s is empty, for optional request fields.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
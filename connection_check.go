@@ -0,0 +1,111 @@
+package unstructured
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ConnectionCheckResult is the final outcome of a connection check that has finished running.
+type ConnectionCheckResult struct {
+	ID          string
+	ConnectorID string
+	Status      ConnectionCheckStatus
+	Reason      *string
+	CreatedAt   time.Time
+	ReportedAt  time.Time
+}
+
+// CheckSourceConnection starts a connection check for the source connector with the given ID
+// and polls until the check reports [ConnectionCheckStatusSuccess] or [ConnectionCheckStatusFailure].
+// If the check fails, the returned error is a [*ConnectionCheckError] carrying the reason.
+func (c *Client) CheckSourceConnection(ctx context.Context, id string) (*ConnectionCheckResult, error) {
+	check, err := c.CreateSourceConnectionCheck(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.waitConnectionCheck(ctx, id, check, c.GetSourceConnectionCheck)
+}
+
+// CheckDestinationConnection starts a connection check for the destination connector with the given ID
+// and polls until the check reports [ConnectionCheckStatusSuccess] or [ConnectionCheckStatusFailure].
+// If the check fails, the returned error is a [*ConnectionCheckError] carrying the reason.
+func (c *Client) CheckDestinationConnection(ctx context.Context, id string) (*ConnectionCheckResult, error) {
+	check, err := c.CreateDestinationConnectionCheck(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.waitConnectionCheck(ctx, id, check, c.GetDestinationConnectionCheck)
+}
+
+func (c *Client) waitConnectionCheck(
+	ctx context.Context,
+	id string,
+	check *DagNodeConnectionCheck,
+	get func(context.Context, string) (*DagNodeConnectionCheck, error),
+) (*ConnectionCheckResult, error) {
+	ticker := time.NewTicker(c.poll)
+	defer ticker.Stop()
+
+	for check.Status == ConnectionCheckStatusScheduled {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to wait for connection check: %w", ctx.Err())
+		case <-ticker.C:
+		}
+
+		var err error
+		if check, err = get(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	result := ConnectionCheckResult{
+		ID:          check.ID,
+		ConnectorID: id,
+		Status:      check.Status,
+		Reason:      check.Reason,
+		CreatedAt:   check.CreatedAt,
+	}
+
+	if check.ReportedAt != nil {
+		t, err := parseTime(*check.ReportedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse connection check report time: %w", err)
+		}
+
+		result.ReportedAt = t
+	}
+
+	switch check.Status {
+	case ConnectionCheckStatusSuccess:
+		return &result, nil
+
+	case ConnectionCheckStatusFailure:
+		return &result, &ConnectionCheckError{
+			ConnectorID: id,
+			Reason:      ToString(check.Reason),
+		}
+
+	default:
+		return &result, fmt.Errorf("unknown connection check status: %s", check.Status)
+	}
+}
+
+// parseTime parses a timestamp as returned by the API, which may or may not carry a time zone.
+// Timestamps without a time zone are interpreted as UTC.
+func parseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse("2006-01-02T15:04:05", strings.TrimSuffix(s, "Z"))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse time %q: %w", s, err)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,113 @@
+package unstructured
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCheckSourceConnection(t *testing.T) {
+	t.Parallel()
+
+	client, mux := testclient(t, WithPollInterval(time.Millisecond))
+
+	id := "a15d4161-77a0-4e08-b65e-86f398ce15ad"
+
+	mux.CreateConnectionCheckSources = func(w http.ResponseWriter, r *http.Request) {
+		if val := r.PathValue("id"); val != id {
+			http.Error(w, "source ID "+val+" not found", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{` +
+			`  "id": "b67be3d6-2b4b-4b4e-9f7e-6f4d5c1a2b3c",` +
+			`  "status": "SCHEDULED",` +
+			`  "reason": null,` +
+			`  "created_at": "2025-06-22T11:37:21.648Z",` +
+			`  "reported_at": null` +
+			`}`))
+	}
+
+	var polls atomic.Int32
+
+	mux.GetConnectionCheckSources = func(w http.ResponseWriter, r *http.Request) {
+		status, reported := `"SCHEDULED"`, `null`
+		if polls.Add(1) > 2 {
+			status, reported = `"SUCCESS"`, `"2025-06-22T11:37:23.148"`
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{` +
+			`  "id": "b67be3d6-2b4b-4b4e-9f7e-6f4d5c1a2b3c",` +
+			`  "status": ` + status + `,` +
+			`  "reason": null,` +
+			`  "created_at": "2025-06-22T11:37:21.648Z",` +
+			`  "reported_at": ` + reported +
+			`}`))
+	}
+
+	result, err := client.CheckSourceConnection(testContext(t), id)
+	if err != nil {
+		t.Fatalf("failed to check source connection: %v", err)
+	}
+
+	if err := errors.Join(
+		eq("result.id", result.ID, "b67be3d6-2b4b-4b4e-9f7e-6f4d5c1a2b3c"),
+		eq("result.connector_id", result.ConnectorID, id),
+		eq("result.status", result.Status, ConnectionCheckStatusSuccess),
+		eq("polls", polls.Load(), 3),
+		equal("result.reported_at", result.ReportedAt, time.Date(2025, 6, 22, 11, 37, 23, 148000000, time.UTC)),
+	); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheckDestinationConnectionFailure(t *testing.T) {
+	t.Parallel()
+
+	client, mux := testclient(t, WithPollInterval(time.Millisecond))
+
+	id := "b1b4a8b6-5d5c-4f5a-9d4e-3c2b1a0f9e8d"
+
+	mux.CreateConnectionCheckDestinations = func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{` +
+			`  "id": "c9f8e7d6-5c4b-3a2f-1e0d-9c8b7a6f5e4d",` +
+			`  "status": "SCHEDULED",` +
+			`  "created_at": "2025-06-22T11:37:21.648Z"` +
+			`}`))
+	}
+
+	mux.GetConnectionCheckDestinations = func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{` +
+			`  "id": "c9f8e7d6-5c4b-3a2f-1e0d-9c8b7a6f5e4d",` +
+			`  "status": "FAILURE",` +
+			`  "reason": "invalid credentials",` +
+			`  "created_at": "2025-06-22T11:37:21.648Z",` +
+			`  "reported_at": "2025-06-22T11:37:22.000Z"` +
+			`}`))
+	}
+
+	result, err := client.CheckDestinationConnection(testContext(t), id)
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	var checkErr *ConnectionCheckError
+	if !errors.As(err, &checkErr) {
+		t.Fatalf("expected error to be an %T, got %T", checkErr, err)
+	}
+
+	if err := errors.Join(
+		eq("error.connector_id", checkErr.ConnectorID, id),
+		eq("error.reason", checkErr.Reason, "invalid credentials"),
+		eq("result.status", result.Status, ConnectionCheckStatusFailure),
+		equal("result.reported_at", result.ReportedAt, time.Date(2025, 6, 22, 11, 37, 22, 0, time.UTC)),
+	); err != nil {
+		t.Error(err)
+	}
+}
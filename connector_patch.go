@@ -0,0 +1,244 @@
+package unstructured
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// PatchSourceRequest represents a partial update of a source connector.
+// The current connector is read first, then Mutate and MergePatch are applied in that order,
+// and the result is sent as a full update.
+//
+// The API masks credentials when a connector is read, so the patch must supply every credential
+// of the config, otherwise it is rejected with a [*MaskedSecretsError] naming the missing fields.
+type PatchSourceRequest struct {
+	ID string
+
+	// Mutate, if set, is called with the current config and may change it in place.
+	Mutate func(cfg SourceConfig) error
+
+	// MergePatch, if set, is a JSON Merge Patch (RFC 7386) applied to the current config.
+	// For example, `{"secret": "new-secret"}` rotates a single credential and
+	// `{"endpoint_url": null}` removes an optional field.
+	MergePatch json.RawMessage
+
+	// UpdatedAt, if non-zero, must match the connector's current UpdatedAt,
+	// otherwise the patch is rejected with a [*StaleConnectorError].
+	UpdatedAt time.Time
+}
+
+// PatchDestinationRequest represents a partial update of a destination connector.
+// The current connector is read first, then Mutate and MergePatch are applied in that order,
+// and the result is sent as a full update.
+//
+// The API masks credentials when a connector is read, so the patch must supply every credential
+// of the config, otherwise it is rejected with a [*MaskedSecretsError] naming the missing fields.
+type PatchDestinationRequest struct {
+	ID string
+
+	// Mutate, if set, is called with the current config and may change it in place.
+	Mutate func(cfg DestinationConfig) error
+
+	// MergePatch, if set, is a JSON Merge Patch (RFC 7386) applied to the current config.
+	MergePatch json.RawMessage
+
+	// UpdatedAt, if non-zero, must match the connector's current UpdatedAt,
+	// otherwise the patch is rejected with a [*StaleConnectorError].
+	UpdatedAt time.Time
+}
+
+// PatchSource reads a source connector, applies the requested changes to it, and sends the result as an update.
+// The UpdatedAt check is performed by the client before the update is sent,
+// so it narrows but does not close the window for concurrent writers.
+func (c *Client) PatchSource(ctx context.Context, in PatchSourceRequest) (*Source, error) {
+	current, err := c.GetSource(ctx, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !in.UpdatedAt.IsZero() && !in.UpdatedAt.Equal(current.UpdatedAt) {
+		return nil, &StaleConnectorError{ID: in.ID, Expected: in.UpdatedAt, Actual: current.UpdatedAt}
+	}
+
+	factory, ok := sourceConfigFactories[current.Config.Type()]
+	if !ok {
+		return nil, fmt.Errorf("unknown source type: %s", current.Config.Type())
+	}
+
+	config, err := patchConfig(current.Config, in.Mutate, in.MergePatch, factory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch source: %w", err)
+	}
+
+	if err := checkMaskedSecrets(in.ID, config); err != nil {
+		return nil, err
+	}
+
+	return c.UpdateSource(ctx, UpdateSourceRequest{
+		ID:     in.ID,
+		Config: config,
+	})
+}
+
+// PatchDestination reads a destination connector, applies the requested changes to it, and sends the result as an update.
+// The UpdatedAt check is performed by the client before the update is sent,
+// so it narrows but does not close the window for concurrent writers.
+func (c *Client) PatchDestination(ctx context.Context, in PatchDestinationRequest) (*Destination, error) {
+	current, err := c.GetDestination(ctx, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !in.UpdatedAt.IsZero() && !in.UpdatedAt.Equal(current.UpdatedAt) {
+		return nil, &StaleConnectorError{ID: in.ID, Expected: in.UpdatedAt, Actual: current.UpdatedAt}
+	}
+
+	factory, ok := destinationConfigFactories[current.Config.Type()]
+	if !ok {
+		return nil, fmt.Errorf("unknown destination type: %s", current.Config.Type())
+	}
+
+	config, err := patchConfig(current.Config, in.Mutate, in.MergePatch, factory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch destination: %w", err)
+	}
+
+	if err := checkMaskedSecrets(in.ID, config); err != nil {
+		return nil, err
+	}
+
+	return c.UpdateDestination(ctx, UpdateDestinationRequest{
+		ID:     in.ID,
+		Config: config,
+	})
+}
+
+// patchConfig applies a mutator and then a JSON Merge Patch to a connector config.
+// The merge patch result is decoded into a fresh value from factory so that removed fields are dropped.
+func patchConfig[T interface{ Type() string }](cfg T, mutate func(T) error, patch json.RawMessage, factory func() T) (T, error) {
+	if mutate != nil {
+		if err := mutate(cfg); err != nil {
+			return cfg, err
+		}
+	}
+
+	if len(patch) == 0 {
+		return cfg, nil
+	}
+
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	merged, err := MergePatch(data, patch)
+	if err != nil {
+		return cfg, err
+	}
+
+	out := factory()
+	if err := json.Unmarshal(merged, out); err != nil {
+		return cfg, fmt.Errorf("failed to unmarshal patched %s config: %w", cfg.Type(), err)
+	}
+
+	return out, nil
+}
+
+// maskedSecret is the value the API returns in place of credentials when a connector is read.
+const maskedSecret = "**********"
+
+// checkMaskedSecrets returns a [*MaskedSecretsError] if any value of a patched config is still masked,
+// so that the masks are not sent back as the new credentials.
+func checkMaskedSecrets(id string, cfg any) error {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	var fields []string
+	maskedFields(v, "", &fields)
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	slices.Sort(fields)
+
+	return &MaskedSecretsError{ID: id, Fields: fields}
+}
+
+// maskedFields appends the dotted paths of the masked string values in v to fields.
+func maskedFields(v any, path string, fields *[]string) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if path != "" {
+				k = path + "." + k
+			}
+
+			maskedFields(val, k, fields)
+		}
+
+	case []any:
+		for i, val := range v {
+			maskedFields(val, path+"["+strconv.Itoa(i)+"]", fields)
+		}
+
+	case string:
+		if v == maskedSecret {
+			*fields = append(*fields, path)
+		}
+	}
+}
+
+// MergePatch applies a JSON Merge Patch, as defined by RFC 7386, to a JSON document and returns the result.
+func MergePatch(doc, patch []byte) ([]byte, error) {
+	var target, p any
+
+	if err := json.Unmarshal(doc, &target); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal merge patch target: %w", err)
+	}
+
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal merge patch: %w", err)
+	}
+
+	data, err := json.Marshal(mergePatch(target, p))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal merge patch result: %w", err)
+	}
+
+	return data, nil
+}
+
+func mergePatch(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = make(map[string]any, len(p))
+	}
+
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+
+		t[k] = mergePatch(t[k], v)
+	}
+
+	return t
+}
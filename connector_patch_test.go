@@ -0,0 +1,270 @@
+package unstructured
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPatchSource(t *testing.T) {
+	t.Parallel()
+
+	id := "a15d4161-77a0-4e08-b65e-86f398ce15ad"
+	updatedAt := time.Date(2025, 6, 22, 11, 37, 21, 648000000, time.UTC)
+
+	setup := func(t *testing.T) (*Client, *[]byte) {
+		client, mux := testclient(t)
+
+		var body []byte
+
+		mux.GetSource = func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{` +
+				`  "id": "` + id + `",` +
+				`  "name": "docs-bucket",` +
+				`  "type": "s3",` +
+				`  "created_at": "2025-06-01T00:00:00Z",` +
+				`  "updated_at": "2025-06-22T11:37:21.648Z",` +
+				`  "config": {` +
+				`    "remote_url": "s3://docs/",` +
+				`    "key": "**********",` +
+				`    "secret": "**********",` +
+				`    "endpoint_url": "https://minio.local"` +
+				`  }` +
+				`}`))
+		}
+
+		mux.UpdateSource = func(w http.ResponseWriter, r *http.Request) {
+			body, _ = io.ReadAll(r.Body)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id": "` + id + `", "name": "docs", "type": "s3", "config": {"remote_url": "s3://docs/"}}`))
+		}
+
+		return client, &body
+	}
+
+	t.Run("merge patch", func(t *testing.T) {
+		t.Parallel()
+
+		client, body := setup(t)
+
+		_, err := client.PatchSource(testContext(t), PatchSourceRequest{
+			ID:         id,
+			MergePatch: json.RawMessage(`{"key": "new-key", "secret": "new-secret", "endpoint_url": null}`),
+			UpdatedAt:  updatedAt,
+		})
+		if err != nil {
+			t.Fatalf("failed to patch source: %v", err)
+		}
+
+		var sent struct {
+			Name   *string           `json:"name"`
+			Config S3ConnectorConfig `json:"config"`
+		}
+		if err := json.Unmarshal(*body, &sent); err != nil {
+			t.Fatalf("failed to unmarshal update body: %v", err)
+		}
+
+		if err := errors.Join(
+			eq("sent.name", sent.Name, nil),
+			eq("sent.config.remote_url", sent.Config.RemoteURL, "s3://docs/"),
+			eq("sent.config.key", ToString(sent.Config.Key), "new-key"),
+			eq("sent.config.secret", ToString(sent.Config.Secret), "new-secret"),
+			eq("sent.config.endpoint_url", sent.Config.EndpointURL, nil),
+		); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("mutate", func(t *testing.T) {
+		t.Parallel()
+
+		client, body := setup(t)
+
+		_, err := client.PatchSource(testContext(t), PatchSourceRequest{
+			ID: id,
+			Mutate: func(cfg SourceConfig) error {
+				s3, ok := cfg.(*S3ConnectorConfig)
+				if !ok {
+					return errors.New("not an s3 source")
+				}
+
+				s3.Recursive = Bool(true)
+				s3.Key = String("new-key")
+				s3.Secret = String("new-secret")
+
+				return nil
+			},
+		})
+		if err != nil {
+			t.Fatalf("failed to patch source: %v", err)
+		}
+
+		var sent struct {
+			Name   *string           `json:"name"`
+			Config S3ConnectorConfig `json:"config"`
+		}
+		if err := json.Unmarshal(*body, &sent); err != nil {
+			t.Fatalf("failed to unmarshal update body: %v", err)
+		}
+
+		if err := errors.Join(
+			eq("sent.name", sent.Name, nil),
+			eq("sent.config.recursive", ToBool(sent.Config.Recursive), true),
+			eq("sent.config.secret", ToString(sent.Config.Secret), "new-secret"),
+		); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("masked secrets", func(t *testing.T) {
+		t.Parallel()
+
+		client, body := setup(t)
+
+		_, err := client.PatchSource(testContext(t), PatchSourceRequest{
+			ID:         id,
+			MergePatch: json.RawMessage(`{"secret": "new-secret"}`),
+		})
+
+		var masked *MaskedSecretsError
+		if !errors.As(err, &masked) {
+			t.Fatalf("expected error to be an %T, got %T: %v", masked, err, err)
+		}
+
+		if err := errors.Join(
+			eqs("error.fields", masked.Fields, []string{"key"}),
+			eq("len(body)", len(*body), 0),
+		); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		t.Parallel()
+
+		client, body := setup(t)
+
+		_, err := client.PatchSource(testContext(t), PatchSourceRequest{
+			ID:        id,
+			UpdatedAt: updatedAt.Add(-time.Minute),
+		})
+
+		var stale *StaleConnectorError
+		if !errors.As(err, &stale) {
+			t.Fatalf("expected error to be an %T, got %T: %v", stale, err, err)
+		}
+
+		if err := errors.Join(
+			equal("error.actual", stale.Actual, updatedAt),
+			eq("len(body)", len(*body), 0),
+		); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestPatchDestinationMaskedSecrets(t *testing.T) {
+	t.Parallel()
+
+	client, _ := fakeclient(t)
+	ctx := testContext(t)
+
+	created, err := client.CreateDestination(ctx, CreateDestinationRequest{
+		Name: "output",
+		Config: &S3ConnectorConfig{
+			RemoteURL: "s3://docs/output/",
+			Key:       String("old-key"),
+			Secret:    String("old-secret"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create destination: %v", err)
+	}
+
+	current, err := client.GetDestination(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("failed to get destination: %v", err)
+	}
+
+	s3, ok := current.Config.(*S3ConnectorConfig)
+	if !ok {
+		t.Fatalf("expected config to be an %T, got %T", s3, current.Config)
+	}
+
+	if err := eq("config.secret", ToString(s3.Secret), maskedSecret); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.PatchDestination(ctx, PatchDestinationRequest{
+		ID:         created.ID,
+		MergePatch: json.RawMessage(`{"remote_url": "s3://docs/archive/"}`),
+	})
+
+	var masked *MaskedSecretsError
+	if !errors.As(err, &masked) {
+		t.Fatalf("expected error to be an %T, got %T: %v", masked, err, err)
+	}
+
+	if err := eqs("error.fields", masked.Fields, []string{"key", "secret"}); err != nil {
+		t.Error(err)
+	}
+
+	updated, err := client.PatchDestination(ctx, PatchDestinationRequest{
+		ID:         created.ID,
+		MergePatch: json.RawMessage(`{"remote_url": "s3://docs/archive/", "key": "new-key", "secret": "new-secret"}`),
+	})
+	if err != nil {
+		t.Fatalf("failed to patch destination: %v", err)
+	}
+
+	s3, ok = updated.Config.(*S3ConnectorConfig)
+	if !ok {
+		t.Fatalf("expected config to be an %T, got %T", s3, updated.Config)
+	}
+
+	if err := errors.Join(
+		eq("config.remote_url", s3.RemoteURL, "s3://docs/archive/"),
+		eq("config.key", ToString(s3.Key), "new-key"),
+		eq("config.secret", ToString(s3.Secret), "new-secret"),
+	); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	t.Parallel()
+
+	// examples from RFC 7386, appendix A
+	for _, tt := range []struct{ doc, patch, want string }{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	} {
+		got, err := MergePatch([]byte(tt.doc), []byte(tt.patch))
+		if err != nil {
+			t.Errorf("MergePatch(%s, %s) returned error: %v", tt.doc, tt.patch, err)
+			continue
+		}
+
+		if string(got) != tt.want {
+			t.Errorf("MergePatch(%s, %s) = %s, want %s", tt.doc, tt.patch, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,732 @@
+// Code generated by internal/gen/connectors from openapi.json; DO NOT EDIT.
+
+package unstructured
+
+// Connector type constants
+const (
+	ConnectorTypeAstraDB                    = "astradb"
+	ConnectorTypeAzure                      = "azure"
+	ConnectorTypeAzureAISearch              = "azure_ai_search"
+	ConnectorTypeBox                        = "box"
+	ConnectorTypeConfluence                 = "confluence"
+	ConnectorTypeCouchbase                  = "couchbase"
+	ConnectorTypeDatabricksVolumeDeltaTable = "databricks_volume_delta_tables"
+	ConnectorTypeDatabricksVolumes          = "databricks_volumes"
+	ConnectorTypeDeltaTable                 = "delta_table"
+	ConnectorTypeDropbox                    = "dropbox"
+	ConnectorTypeElasticsearch              = "elasticsearch"
+	ConnectorTypeGCS                        = "gcs"
+	ConnectorTypeGoogleDrive                = "google_drive"
+	ConnectorTypeIBMWatsonxS3               = "ibm_watsonx_s3"
+	ConnectorTypeJira                       = "jira"
+	ConnectorTypeKafkaCloud                 = "kafka-cloud"
+	ConnectorTypeMilvus                     = "milvus"
+	ConnectorTypeMongoDB                    = "mongodb"
+	ConnectorTypeMotherDuck                 = "motherduck"
+	ConnectorTypeNeo4j                      = "neo4j"
+	ConnectorTypeOneDrive                   = "onedrive"
+	ConnectorTypeOutlook                    = "outlook"
+	ConnectorTypePinecone                   = "pinecone"
+	ConnectorTypePostgres                   = "postgres"
+	ConnectorTypeQdrantCloud                = "qdrant-cloud"
+	ConnectorTypeRedis                      = "redis"
+	ConnectorTypeS3                         = "s3"
+	ConnectorTypeSalesforce                 = "salesforce"
+	ConnectorTypeSharePoint                 = "sharepoint"
+	ConnectorTypeSlack                      = "slack"
+	ConnectorTypeSnowflake                  = "snowflake"
+	ConnectorTypeWeaviateCloud              = "weaviate-cloud"
+	ConnectorTypeZendesk                    = "zendesk"
+)
+
+// sourceConfigFactories maps source type strings to factory functions
+// that create new instances of the appropriate concrete source config type.
+// Using a map here also provides a compile-time check that all source type strings are unique.
+var sourceConfigFactories = map[string]func() SourceConfig{
+	ConnectorTypeAzure:             func() SourceConfig { return new(AzureSourceConnectorConfig) },
+	ConnectorTypeBox:               func() SourceConfig { return new(BoxSourceConnectorConfig) },
+	ConnectorTypeConfluence:        func() SourceConfig { return new(ConfluenceSourceConnectorConfig) },
+	ConnectorTypeCouchbase:         func() SourceConfig { return new(CouchbaseConnectorConfig) },
+	ConnectorTypeDatabricksVolumes: func() SourceConfig { return new(DatabricksVolumesConnectorConfig) },
+	ConnectorTypeDropbox:           func() SourceConfig { return new(DropboxSourceConnectorConfig) },
+	ConnectorTypeElasticsearch:     func() SourceConfig { return new(ElasticsearchConnectorConfig) },
+	ConnectorTypeGCS:               func() SourceConfig { return new(GCSConnectorConfig) },
+	ConnectorTypeGoogleDrive:       func() SourceConfig { return new(GoogleDriveSourceConnectorConfig) },
+	ConnectorTypeJira:              func() SourceConfig { return new(JiraSourceConnectorConfig) },
+	ConnectorTypeKafkaCloud:        func() SourceConfig { return new(KafkaCloudConnectorConfig) },
+	ConnectorTypeMongoDB:           func() SourceConfig { return new(MongoDBConnectorConfig) },
+	ConnectorTypeOneDrive:          func() SourceConfig { return new(OneDriveConnectorConfig) },
+	ConnectorTypeOutlook:           func() SourceConfig { return new(OutlookSourceConnectorConfig) },
+	ConnectorTypePostgres:          func() SourceConfig { return new(PostgresConnectorConfig) },
+	ConnectorTypeS3:                func() SourceConfig { return new(S3ConnectorConfig) },
+	ConnectorTypeSalesforce:        func() SourceConfig { return new(SalesforceSourceConnectorConfig) },
+	ConnectorTypeSharePoint:        func() SourceConfig { return new(SharePointSourceConnectorConfig) },
+	ConnectorTypeSlack:             func() SourceConfig { return new(SlackSourceConnectorConfig) },
+	ConnectorTypeSnowflake:         func() SourceConfig { return new(SnowflakeConnectorConfig) },
+	ConnectorTypeZendesk:           func() SourceConfig { return new(ZendeskSourceConnectorConfig) },
+}
+
+// destinationConfigFactories maps destination type strings to factory functions
+// that create new instances of the appropriate concrete destination config type.
+// Using a map here also provides a compile-time check that all destination type strings are unique.
+var destinationConfigFactories = map[string]func() DestinationConfig{
+	ConnectorTypeAstraDB:                    func() DestinationConfig { return new(AstraDBConnectorConfig) },
+	ConnectorTypeAzureAISearch:              func() DestinationConfig { return new(AzureAISearchConnectorConfig) },
+	ConnectorTypeCouchbase:                  func() DestinationConfig { return new(CouchbaseConnectorConfig) },
+	ConnectorTypeDatabricksVolumeDeltaTable: func() DestinationConfig { return new(DatabricksVDTDestinationConnectorConfig) },
+	ConnectorTypeDatabricksVolumes:          func() DestinationConfig { return new(DatabricksVolumesConnectorConfig) },
+	ConnectorTypeDeltaTable:                 func() DestinationConfig { return new(DeltaTableConnectorConfig) },
+	ConnectorTypeElasticsearch:              func() DestinationConfig { return new(ElasticsearchConnectorConfig) },
+	ConnectorTypeGCS:                        func() DestinationConfig { return new(GCSConnectorConfig) },
+	ConnectorTypeIBMWatsonxS3:               func() DestinationConfig { return new(IBMWatsonxS3DestinationConnectorConfig) },
+	ConnectorTypeKafkaCloud:                 func() DestinationConfig { return new(KafkaCloudConnectorConfig) },
+	ConnectorTypeMilvus:                     func() DestinationConfig { return new(MilvusDestinationConnectorConfig) },
+	ConnectorTypeMongoDB:                    func() DestinationConfig { return new(MongoDBConnectorConfig) },
+	ConnectorTypeMotherDuck:                 func() DestinationConfig { return new(MotherduckDestinationConnectorConfig) },
+	ConnectorTypeNeo4j:                      func() DestinationConfig { return new(Neo4jDestinationConnectorConfig) },
+	ConnectorTypeOneDrive:                   func() DestinationConfig { return new(OneDriveConnectorConfig) },
+	ConnectorTypePinecone:                   func() DestinationConfig { return new(PineconeDestinationConnectorConfig) },
+	ConnectorTypePostgres:                   func() DestinationConfig { return new(PostgresConnectorConfig) },
+	ConnectorTypeQdrantCloud:                func() DestinationConfig { return new(QdrantCloudDestinationConnectorConfig) },
+	ConnectorTypeRedis:                      func() DestinationConfig { return new(RedisDestinationConnectorConfig) },
+	ConnectorTypeS3:                         func() DestinationConfig { return new(S3ConnectorConfig) },
+	ConnectorTypeSnowflake:                  func() DestinationConfig { return new(SnowflakeConnectorConfig) },
+	ConnectorTypeWeaviateCloud:              func() DestinationConfig { return new(WeaviateDestinationConnectorConfig) },
+}
+
+// DatabricksVolumesConnectorConfigInput represents the configuration for a Databricks Volumes connector.
+// It contains host details, catalog information, and authentication credentials.
+type DatabricksVolumesConnectorConfigInput struct {
+	sourceconfig
+	destinationconfig
+
+	Host         string  `json:"host"`
+	Catalog      string  `json:"catalog"`
+	Schema       *string `json:"schema,omitempty"`
+	Volume       string  `json:"volume"`
+	VolumePath   string  `json:"volume_path"`
+	ClientSecret string  `json:"client_secret"`
+	ClientID     string  `json:"client_id"`
+}
+
+// Type always returns the connector type identifier for Databricks Volumes: "databricks_volumes".
+func (c DatabricksVolumesConnectorConfigInput) Type() string { return ConnectorTypeDatabricksVolumes }
+
+// ElasticsearchConnectorConfigInput represents the configuration for an Elasticsearch connector.
+// It contains host details, index information, and API key authentication.
+type ElasticsearchConnectorConfigInput struct {
+	sourceconfig
+	destinationconfig
+
+	Hosts     []string `json:"hosts"`
+	IndexName string   `json:"index_name"`
+	ESAPIKey  string   `json:"es_api_key"`
+}
+
+// Type always returns the connector type identifier for Elasticsearch: "elasticsearch".
+func (c ElasticsearchConnectorConfigInput) Type() string { return ConnectorTypeElasticsearch }
+
+// MongoDBConnectorConfigInput represents the configuration for a MongoDB connector.
+// It contains database connection details and collection information.
+type MongoDBConnectorConfigInput struct {
+	sourceconfig
+	destinationconfig
+
+	Database   string `json:"database"`
+	Collection string `json:"collection"`
+	URI        string `json:"uri"`
+}
+
+// Type always returns the connector type identifier for MongoDB: "mongodb".
+func (c MongoDBConnectorConfigInput) Type() string { return ConnectorTypeMongoDB }
+
+// DatabricksVolumesConnectorConfig represents the configuration for a Databricks Volumes connector.
+// It contains host details, catalog information, and authentication credentials.
+type DatabricksVolumesConnectorConfig struct {
+	sourceconfig
+	destinationconfig
+
+	Host         string  `json:"host"`
+	Catalog      string  `json:"catalog"`
+	Schema       *string `json:"schema,omitempty"`
+	Volume       string  `json:"volume"`
+	VolumePath   string  `json:"volume_path"`
+	ClientSecret string  `json:"client_secret"`
+	ClientID     string  `json:"client_id"`
+}
+
+var _ SourceConfig = (*DatabricksVolumesConnectorConfig)(nil)
+var _ DestinationConfig = (*DatabricksVolumesConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Databricks Volumes: "databricks_volumes".
+func (c DatabricksVolumesConnectorConfig) Type() string { return ConnectorTypeDatabricksVolumes }
+
+// ElasticsearchConnectorConfig represents the configuration for an Elasticsearch connector.
+// It contains host details, index information, and API key authentication.
+type ElasticsearchConnectorConfig struct {
+	sourceconfig
+	destinationconfig
+
+	Hosts     []string `json:"hosts"`
+	IndexName string   `json:"index_name"`
+	ESAPIKey  string   `json:"es_api_key"`
+}
+
+var _ SourceConfig = (*ElasticsearchConnectorConfig)(nil)
+var _ DestinationConfig = (*ElasticsearchConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Elasticsearch: "elasticsearch".
+func (c ElasticsearchConnectorConfig) Type() string { return ConnectorTypeElasticsearch }
+
+// MongoDBConnectorConfig represents the configuration for a MongoDB connector.
+// It contains database connection details and collection information.
+type MongoDBConnectorConfig struct {
+	sourceconfig
+	destinationconfig
+
+	Database   string `json:"database"`
+	Collection string `json:"collection"`
+	URI        string `json:"uri"`
+}
+
+var _ SourceConfig = (*MongoDBConnectorConfig)(nil)
+var _ DestinationConfig = (*MongoDBConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for MongoDB: "mongodb".
+func (c MongoDBConnectorConfig) Type() string { return ConnectorTypeMongoDB }
+
+// CouchbaseConnectorConfig represents the configuration for a Couchbase connector.
+// It contains connection details, bucket information, and authentication credentials.
+type CouchbaseConnectorConfig struct {
+	sourceconfig
+	destinationconfig
+
+	Bucket           string  `json:"bucket"`
+	ConnectionString string  `json:"connection_string"`
+	Scope            *string `json:"scope,omitempty"`
+	Collection       *string `json:"collection,omitempty"`
+	BatchSize        int     `json:"batch_size"`
+	Username         string  `json:"username"`
+	Password         string  `json:"password"`
+	CollectionID     *string `json:"collection_id,omitempty"`
+}
+
+var _ SourceConfig = (*CouchbaseConnectorConfig)(nil)
+var _ DestinationConfig = (*CouchbaseConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Couchbase: "couchbase".
+func (c CouchbaseConnectorConfig) Type() string { return ConnectorTypeCouchbase }
+
+// S3ConnectorConfig represents the configuration for an S3 connector.
+// It supports both AWS S3 and S3-compatible storage services.
+type S3ConnectorConfig struct {
+	sourceconfig
+	destinationconfig
+
+	RemoteURL   string  `json:"remote_url"`
+	Anonymous   *bool   `json:"anonymous,omitempty"`
+	Key         *string `json:"key,omitempty"`
+	Secret      *string `json:"secret,omitempty"`
+	Token       *string `json:"token,omitempty"`
+	EndpointURL *string `json:"endpoint_url,omitempty"`
+	Recursive   *bool   `json:"recursive,omitempty"`
+}
+
+var _ SourceConfig = (*S3ConnectorConfig)(nil)
+var _ DestinationConfig = (*S3ConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for S3: "s3".
+func (c S3ConnectorConfig) Type() string { return ConnectorTypeS3 }
+
+// GCSConnectorConfig represents the configuration for a Google Cloud Storage connector.
+// It contains the remote URL and service account key for authentication.
+type GCSConnectorConfig struct {
+	sourceconfig
+	destinationconfig
+
+	RemoteURL         string `json:"remote_url"`
+	ServiceAccountKey string `json:"service_account_key"`
+	Recursive         *bool  `json:"recursive,omitempty"`
+}
+
+var _ SourceConfig = (*GCSConnectorConfig)(nil)
+var _ DestinationConfig = (*GCSConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for GCS: "gcs".
+func (c GCSConnectorConfig) Type() string { return ConnectorTypeGCS }
+
+// KafkaCloudConnectorConfig represents the configuration for a Kafka Cloud connector.
+// It contains broker details, topic information, and authentication credentials.
+type KafkaCloudConnectorConfig struct {
+	sourceconfig
+	destinationconfig
+
+	BootstrapServers     string  `json:"bootstrap_servers"`
+	Port                 *int    `json:"port,omitempty"`
+	GroupID              *string `json:"group_id,omitempty"`
+	Topic                string  `json:"topic"`
+	KafkaAPIKey          string  `json:"kafka_api_key"`
+	Secret               string  `json:"secret"`
+	NumMessagesToConsume *int    `json:"num_messages_to_consume,omitempty"`
+	BatchSize            *int    `json:"batch_size,omitempty"`
+}
+
+var _ SourceConfig = (*KafkaCloudConnectorConfig)(nil)
+var _ DestinationConfig = (*KafkaCloudConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Kafka Cloud: "kafka-cloud".
+func (c KafkaCloudConnectorConfig) Type() string { return ConnectorTypeKafkaCloud }
+
+// PostgresConnectorConfig represents the configuration for a PostgreSQL connector.
+// It contains database connection details and table configuration.
+type PostgresConnectorConfig struct {
+	sourceconfig
+	destinationconfig
+
+	Host      string   `json:"host"`
+	Database  string   `json:"database"`
+	Port      int      `json:"port"`
+	Username  string   `json:"username"`
+	Password  string   `json:"password"`
+	TableName string   `json:"table_name"`
+	BatchSize int      `json:"batch_size"`
+	IDColumn  *string  `json:"id_column,omitempty"`
+	Fields    []string `json:"fields,omitempty"`
+}
+
+var _ SourceConfig = (*PostgresConnectorConfig)(nil)
+var _ DestinationConfig = (*PostgresConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for PostgreSQL: "postgres".
+func (c PostgresConnectorConfig) Type() string { return ConnectorTypePostgres }
+
+// SnowflakeConnectorConfig represents the configuration for a Snowflake connector.
+// It contains account details, authentication, and table configuration.
+type SnowflakeConnectorConfig struct {
+	sourceconfig
+	destinationconfig
+
+	Account     string   `json:"account"`
+	Role        string   `json:"role"`
+	User        string   `json:"user"`
+	Password    string   `json:"password"`
+	Host        string   `json:"host"`
+	Port        *int     `json:"port,omitempty"`
+	Database    string   `json:"database"`
+	Schema      *string  `json:"schema,omitempty"`
+	TableName   *string  `json:"table_name,omitempty"`
+	BatchSize   *int     `json:"batch_size,omitempty"`
+	IDColumn    *string  `json:"id_column,omitempty"`
+	Fields      []string `json:"fields,omitempty"`
+	RecordIDKey *string  `json:"record_id_key,omitempty"`
+}
+
+var _ SourceConfig = (*SnowflakeConnectorConfig)(nil)
+var _ DestinationConfig = (*SnowflakeConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Snowflake: "snowflake".
+func (c SnowflakeConnectorConfig) Type() string { return ConnectorTypeSnowflake }
+
+// OneDriveConnectorConfig represents the configuration for a OneDrive connector.
+// It contains Microsoft Graph API authentication and file access settings.
+type OneDriveConnectorConfig struct {
+	sourceconfig
+	destinationconfig
+
+	ClientID     string  `json:"client_id"`
+	UserPName    string  `json:"user_pname"`
+	Tenant       string  `json:"tenant"`
+	AuthorityURL string  `json:"authority_url"`
+	ClientCred   string  `json:"client_cred"`
+	Recursive    *bool   `json:"recursive,omitempty"`
+	Path         *string `json:"path,omitempty"`
+	RemoteURL    *string `json:"remote_url,omitempty"`
+}
+
+var _ SourceConfig = (*OneDriveConnectorConfig)(nil)
+var _ DestinationConfig = (*OneDriveConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for OneDrive: "onedrive".
+func (c OneDriveConnectorConfig) Type() string { return ConnectorTypeOneDrive }
+
+// AzureSourceConnectorConfig represents the configuration for an Azure Blob Storage source connector.
+// It supports authentication via connection string, account key, or SAS token.
+type AzureSourceConnectorConfig struct {
+	sourceconfig
+
+	RemoteURL        string  `json:"remote_url"`
+	AccountName      *string `json:"account_name,omitempty"`
+	AccountKey       *string `json:"account_key,omitempty"`
+	ConnectionString *string `json:"connection_string,omitempty"`
+	SASToken         *string `json:"sas_token,omitempty"`
+	Recursive        *bool   `json:"recursive,omitempty"`
+}
+
+var _ SourceConfig = (*AzureSourceConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Azure: "azure".
+func (c AzureSourceConnectorConfig) Type() string { return ConnectorTypeAzure }
+
+// BoxSourceConnectorConfig represents the configuration for a Box source connector.
+// It contains Box app configuration and file access settings.
+type BoxSourceConnectorConfig struct {
+	sourceconfig
+
+	BoxAppConfig string `json:"box_app_config"`
+	RemoteURL    string `json:"remote_url"`
+	Recursive    *bool  `json:"recursive,omitempty"`
+}
+
+var _ SourceConfig = (*BoxSourceConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Box: "box".
+func (c BoxSourceConnectorConfig) Type() string { return ConnectorTypeBox }
+
+// ConfluenceSourceConnectorConfig represents the configuration for a Confluence source connector.
+// It contains authentication details and content extraction settings.
+type ConfluenceSourceConnectorConfig struct {
+	sourceconfig
+
+	URL                       string   `json:"url"`
+	Username                  string   `json:"username"`
+	Password                  *string  `json:"password,omitempty"`
+	APIToken                  *string  `json:"api_token,omitempty"`
+	Token                     *string  `json:"token,omitempty"`
+	Cloud                     *bool    `json:"cloud,omitempty"`
+	ExtractImages             *bool    `json:"extract_images,omitempty"`
+	ExtractFiles              *bool    `json:"extract_files,omitempty"`
+	MaxNumOfSpaces            *int     `json:"max_num_of_spaces,omitempty"`
+	MaxNumOfDocsFromEachSpace *int     `json:"max_num_of_docs_from_each_space,omitempty"`
+	Spaces                    []string `json:"spaces,omitempty"`
+}
+
+var _ SourceConfig = (*ConfluenceSourceConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Confluence: "confluence".
+func (c ConfluenceSourceConnectorConfig) Type() string { return ConnectorTypeConfluence }
+
+// JiraSourceConnectorConfig represents the configuration for a Jira source connector.
+// It contains authentication details and project/issue filtering settings.
+type JiraSourceConnectorConfig struct {
+	sourceconfig
+
+	URL                 string   `json:"url"`
+	Username            string   `json:"username"`
+	Password            *string  `json:"password,omitempty"`
+	Token               *string  `json:"token,omitempty"`
+	Cloud               *bool    `json:"cloud,omitempty"`
+	Projects            []string `json:"projects,omitempty"`
+	Boards              []string `json:"boards,omitempty"`
+	Issues              []string `json:"issues,omitempty"`
+	StatusFilters       []string `json:"status_filters,omitempty"`
+	DownloadAttachments *bool    `json:"download_attachments,omitempty"`
+}
+
+var _ SourceConfig = (*JiraSourceConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Jira: "jira".
+func (c JiraSourceConnectorConfig) Type() string { return ConnectorTypeJira }
+
+// SharePointSourceConnectorConfig represents the configuration for a SharePoint source connector.
+// It contains Microsoft Graph API authentication and site access details.
+type SharePointSourceConnectorConfig struct {
+	sourceconfig
+
+	Site         string  `json:"site"`
+	Tenant       string  `json:"tenant"`
+	AuthorityURL *string `json:"authority_url,omitempty"`
+	UserPName    string  `json:"user_pname"`
+	ClientID     string  `json:"client_id"`
+	ClientCred   string  `json:"client_cred"`
+	Recursive    *bool   `json:"recursive,omitempty"`
+	Path         *string `json:"path,omitempty"`
+}
+
+var _ SourceConfig = (*SharePointSourceConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for SharePoint: "sharepoint".
+func (c SharePointSourceConnectorConfig) Type() string { return ConnectorTypeSharePoint }
+
+// DropboxSourceConnectorConfig represents the configuration for a Dropbox source connector.
+// It contains access token and file path configuration.
+type DropboxSourceConnectorConfig struct {
+	sourceconfig
+
+	Token     string `json:"token"`
+	RemoteURL string `json:"remote_url"`
+	Recursive *bool  `json:"recursive,omitempty"`
+}
+
+var _ SourceConfig = (*DropboxSourceConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Dropbox: "dropbox".
+func (c DropboxSourceConnectorConfig) Type() string { return ConnectorTypeDropbox }
+
+// GoogleDriveSourceConnectorConfig represents the configuration for a Google Drive source connector.
+// It contains drive ID, service account key, and file filtering settings.
+type GoogleDriveSourceConnectorConfig struct {
+	sourceconfig
+
+	DriveID           string   `json:"drive_id"`
+	ServiceAccountKey *string  `json:"service_account_key,omitempty"`
+	Extensions        []string `json:"extensions,omitempty"`
+	Recursive         *bool    `json:"recursive,omitempty"`
+}
+
+var _ SourceConfig = (*GoogleDriveSourceConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Google Drive: "google_drive".
+func (c GoogleDriveSourceConnectorConfig) Type() string { return ConnectorTypeGoogleDrive }
+
+// OutlookSourceConnectorConfig represents the configuration for an Outlook source connector.
+// It contains Microsoft Graph API authentication and email folder settings.
+type OutlookSourceConnectorConfig struct {
+	sourceconfig
+
+	AuthorityURL   *string  `json:"authority_url,omitempty"`
+	Tenant         *string  `json:"tenant,omitempty"`
+	ClientID       string   `json:"client_id"`
+	ClientCred     string   `json:"client_cred"`
+	OutlookFolders []string `json:"outlook_folders,omitempty"`
+	Recursive      *bool    `json:"recursive,omitempty"`
+	UserEmail      string   `json:"user_email"`
+}
+
+var _ SourceConfig = (*OutlookSourceConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Outlook: "outlook".
+func (c OutlookSourceConnectorConfig) Type() string { return ConnectorTypeOutlook }
+
+// SalesforceSourceConnectorConfig represents the configuration for a Salesforce source connector.
+// It contains authentication details and data category filtering.
+type SalesforceSourceConnectorConfig struct {
+	sourceconfig
+
+	Username    string   `json:"username"`
+	ConsumerKey string   `json:"consumer_key"`
+	PrivateKey  string   `json:"private_key"`
+	Categories  []string `json:"categories"`
+}
+
+var _ SourceConfig = (*SalesforceSourceConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Salesforce: "salesforce".
+func (c SalesforceSourceConnectorConfig) Type() string { return ConnectorTypeSalesforce }
+
+// ZendeskSourceConnectorConfig represents the configuration for a Zendesk source connector.
+// It contains subdomain, authentication, and item type filtering.
+type ZendeskSourceConnectorConfig struct {
+	sourceconfig
+
+	Subdomain string  `json:"subdomain"`
+	Email     string  `json:"email"`
+	APIToken  string  `json:"api_token"`
+	ItemType  *string `json:"item_type,omitempty"`
+	BatchSize *int    `json:"batch_size,omitempty"`
+}
+
+var _ SourceConfig = (*ZendeskSourceConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Zendesk: "zendesk".
+func (c ZendeskSourceConnectorConfig) Type() string { return ConnectorTypeZendesk }
+
+// AstraDBConnectorConfig represents the configuration for an AstraDB destination connector.
+// It contains the collection name, keyspace, batch size, API endpoint, and token.
+type AstraDBConnectorConfig struct {
+	destinationconfig
+
+	CollectionName  string  `json:"collection_name"`
+	Keyspace        *string `json:"keyspace,omitempty"`
+	BatchSize       *int    `json:"batch_size,omitempty"`
+	APIEndpoint     string  `json:"api_endpoint"`
+	Token           string  `json:"token"`
+	FlattenMetadata *bool   `json:"flatten_metadata,omitempty"`
+}
+
+var _ DestinationConfig = (*AstraDBConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for AstraDB: "astradb".
+func (c AstraDBConnectorConfig) Type() string { return ConnectorTypeAstraDB }
+
+// AzureAISearchConnectorConfig represents the configuration for an Azure AI Search destination connector.
+// It contains the endpoint, index name, and API key.
+type AzureAISearchConnectorConfig struct {
+	destinationconfig
+
+	Endpoint string `json:"endpoint"`
+	Index    string `json:"index"`
+	Key      string `json:"key"`
+}
+
+var _ DestinationConfig = (*AzureAISearchConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Azure AI Search: "azure_ai_search".
+func (c AzureAISearchConnectorConfig) Type() string { return ConnectorTypeAzureAISearch }
+
+// DatabricksVDTDestinationConnectorConfig represents the configuration for a Databricks Volume Delta Tables destination connector.
+// It contains server details, authentication, and table configuration.
+type DatabricksVDTDestinationConnectorConfig struct {
+	destinationconfig
+
+	ServerHostname string  `json:"server_hostname"`
+	HTTPPath       string  `json:"http_path"`
+	Token          *string `json:"token,omitempty"`
+	ClientID       *string `json:"client_id,omitempty"`
+	ClientSecret   *string `json:"client_secret,omitempty"`
+	Catalog        string  `json:"catalog"`
+	Database       *string `json:"database,omitempty"`
+	TableName      *string `json:"table_name,omitempty"`
+	Schema         *string `json:"schema,omitempty"`
+	Volume         string  `json:"volume"`
+	VolumePath     *string `json:"volume_path,omitempty"`
+}
+
+var _ DestinationConfig = (*DatabricksVDTDestinationConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Databricks Volume Delta Tables: "databricks_volume_delta_tables".
+func (c DatabricksVDTDestinationConnectorConfig) Type() string {
+	return ConnectorTypeDatabricksVolumeDeltaTable
+}
+
+// DeltaTableConnectorConfig represents the configuration for a Delta Table destination connector.
+// It contains AWS credentials and table URI for Delta Lake storage.
+type DeltaTableConnectorConfig struct {
+	destinationconfig
+
+	AwsAccessKeyID     string `json:"aws_access_key_id"`
+	AwsSecretAccessKey string `json:"aws_secret_access_key"`
+	AwsRegion          string `json:"aws_region"`
+	TableURI           string `json:"table_uri"`
+}
+
+var _ DestinationConfig = (*DeltaTableConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Delta Table: "delta_table".
+func (c DeltaTableConnectorConfig) Type() string { return ConnectorTypeDeltaTable }
+
+// MilvusDestinationConnectorConfig represents the configuration for a Milvus destination connector.
+// It contains connection details, collection information, and authentication.
+type MilvusDestinationConnectorConfig struct {
+	destinationconfig
+
+	URI            string  `json:"uri"`
+	User           *string `json:"user,omitempty"`
+	Token          *string `json:"token,omitempty"`
+	Password       *string `json:"password,omitempty"`
+	DBName         *string `json:"db_name,omitempty"`
+	CollectionName string  `json:"collection_name"`
+	RecordIDKey    string  `json:"record_id_key"`
+}
+
+var _ DestinationConfig = (*MilvusDestinationConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Milvus: "milvus".
+func (c MilvusDestinationConnectorConfig) Type() string { return ConnectorTypeMilvus }
+
+// Neo4jDestinationConnectorConfig represents the configuration for a Neo4j destination connector.
+// It contains database connection details and authentication credentials.
+type Neo4jDestinationConnectorConfig struct {
+	destinationconfig
+
+	URI       string `json:"uri"`
+	Database  string `json:"database"`
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+	BatchSize *int   `json:"batch_size,omitempty"`
+}
+
+var _ DestinationConfig = (*Neo4jDestinationConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Neo4j: "neo4j".
+func (c Neo4jDestinationConnectorConfig) Type() string { return ConnectorTypeNeo4j }
+
+// PineconeDestinationConnectorConfig represents the configuration for a Pinecone destination connector.
+// It contains index details, API key, and namespace information.
+type PineconeDestinationConnectorConfig struct {
+	destinationconfig
+
+	IndexName string `json:"index_name"`
+	APIKey    string `json:"api_key"`
+	Namespace string `json:"namespace"`
+	BatchSize *int   `json:"batch_size,omitempty"`
+}
+
+var _ DestinationConfig = (*PineconeDestinationConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Pinecone: "pinecone".
+func (c PineconeDestinationConnectorConfig) Type() string { return ConnectorTypePinecone }
+
+// RedisDestinationConnectorConfig represents the configuration for a Redis destination connector.
+// It contains connection details, database selection, and authentication.
+type RedisDestinationConnectorConfig struct {
+	destinationconfig
+
+	Host      string  `json:"host"`
+	Port      *int    `json:"port,omitempty"`
+	Username  *string `json:"username,omitempty"`
+	Password  *string `json:"password,omitempty"`
+	URI       *string `json:"uri,omitempty"`
+	Database  *int    `json:"database,omitempty"`
+	SSL       *bool   `json:"ssl,omitempty"`
+	BatchSize *int    `json:"batch_size,omitempty"`
+}
+
+var _ DestinationConfig = (*RedisDestinationConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Redis: "redis".
+func (c RedisDestinationConnectorConfig) Type() string { return ConnectorTypeRedis }
+
+// QdrantCloudDestinationConnectorConfig represents the configuration for a Qdrant Cloud destination connector.
+// It contains API endpoint, collection details, and authentication.
+type QdrantCloudDestinationConnectorConfig struct {
+	destinationconfig
+
+	URL            string `json:"url"`
+	APIKey         string `json:"api_key"`
+	CollectionName string `json:"collection_name"`
+	BatchSize      *int   `json:"batch_size,omitempty"`
+}
+
+var _ DestinationConfig = (*QdrantCloudDestinationConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Qdrant Cloud: "qdrant-cloud".
+func (c QdrantCloudDestinationConnectorConfig) Type() string { return ConnectorTypeQdrantCloud }
+
+// WeaviateDestinationConnectorConfig represents the configuration for a Weaviate destination connector.
+// It contains cluster URL, API key, and collection information.
+type WeaviateDestinationConnectorConfig struct {
+	destinationconfig
+
+	ClusterURL string  `json:"cluster_url"`
+	APIKey     string  `json:"api_key"`
+	Collection *string `json:"collection,omitempty"`
+}
+
+var _ DestinationConfig = (*WeaviateDestinationConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for Weaviate Cloud: "weaviate-cloud".
+func (c WeaviateDestinationConnectorConfig) Type() string { return ConnectorTypeWeaviateCloud }
+
+// IBMWatsonxS3DestinationConnectorConfig represents the configuration for an IBM Watsonx S3 destination connector.
+// It contains IBM Cloud authentication, storage endpoints, and table configuration.
+type IBMWatsonxS3DestinationConnectorConfig struct {
+	destinationconfig
+
+	IAMApiKey             string  `json:"iam_api_key"`
+	AccessKeyID           string  `json:"access_key_id"`
+	SecretAccessKey       string  `json:"secret_access_key"`
+	IcebergEndpoint       string  `json:"iceberg_endpoint"`
+	ObjectStorageEndpoint string  `json:"object_storage_endpoint"`
+	ObjectStorageRegion   string  `json:"object_storage_region"`
+	Catalog               string  `json:"catalog"`
+	MaxRetriesConnection  *int    `json:"max_retries_connection,omitempty"`
+	Namespace             string  `json:"namespace"`
+	Table                 string  `json:"table"`
+	MaxRetries            *int    `json:"max_retries,omitempty"`
+	RecordIDKey           *string `json:"record_id_key,omitempty"`
+}
+
+var _ DestinationConfig = (*IBMWatsonxS3DestinationConnectorConfig)(nil)
+
+// Type always returns the connector type identifier for IBM Watsonx S3: "ibm_watsonx_s3".
+func (c IBMWatsonxS3DestinationConnectorConfig) Type() string { return ConnectorTypeIBMWatsonxS3 }
@@ -44,7 +44,7 @@ func (c *Client) CreateDestination(ctx context.Context, in CreateDestinationRequ
 
 	var destination Destination
 	if err := c.do(req, &destination); err != nil {
-		return nil, fmt.Errorf("failed to create destination: %w", err)
+		return nil, fmt.Errorf("failed to create destination: %w", withRequest(err, in))
 	}
 
 	return &destination, nil
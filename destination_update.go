@@ -16,6 +16,7 @@ type UpdateDestinationRequest struct {
 
 // UpdateDestination updates the configuration of an existing destination connector.
 // It returns the updated destination connector.
+// The API does not support renaming connectors, so only the config is updated.
 func (c *Client) UpdateDestination(ctx context.Context, in UpdateDestinationRequest) (*Destination, error) {
 	config, err := json.Marshal(in.Config)
 	if err != nil {
@@ -46,7 +47,7 @@ func (c *Client) UpdateDestination(ctx context.Context, in UpdateDestinationRequ
 
 	var destination Destination
 	if err := c.do(req, &destination); err != nil {
-		return nil, fmt.Errorf("failed to update destination: %w", err)
+		return nil, fmt.Errorf("failed to update destination: %w", withRequest(err, in))
 	}
 
 	return &destination, nil
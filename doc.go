@@ -285,6 +285,14 @@ Connection Testing
 		log.Println("Connection check in progress")
 	}
 
+	// Or start a check and wait for it to finish
+	result, err := client.CheckSourceConnection(ctx, "source-id")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Connection successful, reported at %s", result.ReportedAt)
+
 # Error Handling
 
 The package provides comprehensive error handling:
@@ -295,17 +303,26 @@ The package provides comprehensive error handling:
 		ve := new(HTTPValidationError)
 		if errors.As(err, &ve) {
 			log.Printf("Validation failed: %v", ve)
-			for _, detail := range ve.Detail {
-			    log.Printf("  - %s at %v: %s", detail.Type, detail.Location, detail.Message)
+			for _, field := range ve.FieldErrors() {
+			    log.Printf("  - %s at %s: %s", field.Type, field.Field, field.Message)
 			}
 			return
 		}
 
+		// Check for common failures by status
+		if errors.Is(err, ErrNotFound) {
+			log.Printf("No such source")
+			return
+		}
+
 		// Handle other errors
 		log.Printf("Source creation failed: %v", err)
 		return
 	}
 
+API errors are [*APIError] values, which match the sentinel errors [ErrUnauthorized], [ErrForbidden],
+[ErrNotFound], [ErrConflict], [ErrRateLimited] and [ErrServer] with [errors.Is], depending on their status.
+
 # Supported File Types
 
 The Unstructured.io platform supports a wide variety of file types including:
@@ -333,6 +350,11 @@ The package supports API key authentication:
 		unstructured.WithKey("your-api-key"),
 	)
 
+Endpoints, keys, timeouts, retries and rate limits for several accounts can be kept as named profiles
+in a config file, see [LoadConfig] and [WithProfile]:
+
+	client, err := unstructured.New(unstructured.WithProfile("staging"))
+
 # Helper Functions
 
 The package provides several helper functions for working with pointers to primitive types.
@@ -0,0 +1,116 @@
+package unstructured
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DistanceMetric is the similarity measure a vector index should use for the embeddings of a model.
+type DistanceMetric string
+
+// DistanceMetric constants.
+const (
+	DistanceCosine     DistanceMetric = "cosine"
+	DistanceDotProduct DistanceMetric = "dot_product"
+	DistanceEuclidean  DistanceMetric = "euclidean"
+)
+
+// charsPerToken is a conservative estimate of the characters of text per token of an embedding model.
+// English text averages about four; code, numbers and other languages take fewer.
+const charsPerToken = 3
+
+// EmbedderModelInfo describes an embedding model, see [EmbedderModels].
+type EmbedderModelInfo struct {
+	Model   EmbedderModel   `json:"model"`
+	Subtype EmbedderSubtype `json:"subtype"`
+
+	// Dimensions is the length of the vectors the model returns, which is the default one
+	// for models that support several.
+	Dimensions int `json:"dimensions"`
+
+	// MaxInputTokens is the most tokens of text the model embeds at once. Longer input is truncated or rejected.
+	MaxInputTokens int `json:"max_input_tokens"`
+
+	// Multimodal reports whether the model also embeds images.
+	Multimodal bool `json:"multimodal"`
+
+	// Distance is the metric the model is trained for.
+	Distance DistanceMetric `json:"distance"`
+}
+
+var embedderModels = []EmbedderModelInfo{
+	{EmbedderModelAzureOpenAITextEmbedding3Small, EmbedderSubtypeAzureOpenAI, 1536, 8191, false, DistanceCosine},
+	{EmbedderModelAzureOpenAITextEmbedding3Large, EmbedderSubtypeAzureOpenAI, 3072, 8191, false, DistanceCosine},
+	{EmbedderModelAzureOpenAITextEmbeddingAda002, EmbedderSubtypeAzureOpenAI, 1536, 8191, false, DistanceCosine},
+
+	{EmbedderModelBedrockTitanEmbedTextV2, EmbedderSubtypeBedrock, 1024, 8192, false, DistanceCosine},
+	{EmbedderModelBedrockTitanEmbedTextV1, EmbedderSubtypeBedrock, 1536, 8192, false, DistanceCosine},
+	{EmbedderModelBedrockTitanEmbedImageV1, EmbedderSubtypeBedrock, 1024, 128, true, DistanceCosine},
+	{EmbedderModelBedrockCohereEmbedEnglish, EmbedderSubtypeBedrock, 1024, 512, false, DistanceCosine},
+	{EmbedderModelBedrockCohereEmbedMultilingual, EmbedderSubtypeBedrock, 1024, 512, false, DistanceCosine},
+
+	{EmbedderModelTogetherAIM2Bert80M32kRetrieval, EmbedderSubtypeTogetherAI, 768, 32768, false, DistanceCosine},
+
+	{EmbedderModelVoyageAI3, EmbedderSubtypeVoyageAI, 1024, 32000, false, DistanceCosine},
+	{EmbedderModelVoyageAI3Large, EmbedderSubtypeVoyageAI, 1024, 32000, false, DistanceCosine},
+	{EmbedderModelVoyageAI3Lite, EmbedderSubtypeVoyageAI, 512, 32000, false, DistanceCosine},
+	{EmbedderModelVoyageAICode3, EmbedderSubtypeVoyageAI, 1024, 32000, false, DistanceCosine},
+	{EmbedderModelVoyageAIFinance2, EmbedderSubtypeVoyageAI, 1024, 32000, false, DistanceCosine},
+	{EmbedderModelVoyageAILaw2, EmbedderSubtypeVoyageAI, 1024, 16000, false, DistanceCosine},
+	{EmbedderModelVoyageAICode2, EmbedderSubtypeVoyageAI, 1536, 16000, false, DistanceCosine},
+	{EmbedderModelVoyageAIMultimodal3, EmbedderSubtypeVoyageAI, 1024, 32000, true, DistanceCosine},
+}
+
+// EmbedderModels returns the known embedding models of the given subtype, or of all subtypes if it is empty.
+func EmbedderModels(subtype EmbedderSubtype) []EmbedderModelInfo {
+	models := make([]EmbedderModelInfo, 0, len(embedderModels))
+
+	for _, m := range embedderModels {
+		if subtype == "" || m.Subtype == subtype {
+			models = append(models, m)
+		}
+	}
+
+	return models
+}
+
+// LookupEmbedderModel returns the description of the given embedding model, if it is known.
+func LookupEmbedderModel(model EmbedderModel) (EmbedderModelInfo, bool) {
+	i := slices.IndexFunc(embedderModels, func(m EmbedderModelInfo) bool { return m.Model == model })
+	if i < 0 {
+		return EmbedderModelInfo{}, false
+	}
+
+	return embedderModels[i], true
+}
+
+// ModelInfo returns the description of the embedder's model, if it is known and belongs to the embedder's subtype.
+func (e *Embedder) ModelInfo() (EmbedderModelInfo, bool) {
+	m, ok := LookupEmbedderModel(e.ModelName)
+	if !ok || m.Subtype != e.Subtype {
+		return EmbedderModelInfo{}, false
+	}
+
+	return m, true
+}
+
+// MaxCharacters estimates the longest text, in characters, that the model embeds without truncating it,
+// assuming three characters per token.
+func (m EmbedderModelInfo) MaxCharacters() int {
+	return m.MaxInputTokens * charsPerToken
+}
+
+// CheckMaxCharacters returns an error if chunks of up to maxCharacters characters, as set on a chunker node,
+// may be too long for the model to embed. A maxCharacters of zero stands for the API's default of 500.
+func (m EmbedderModelInfo) CheckMaxCharacters(maxCharacters int) error {
+	if maxCharacters == 0 {
+		maxCharacters = 500
+	}
+
+	if limit := m.MaxCharacters(); maxCharacters > limit {
+		return fmt.Errorf("chunks of up to %d characters may exceed the %d input tokens of %s, keep them under %d characters",
+			maxCharacters, m.MaxInputTokens, m.Model, limit)
+	}
+
+	return nil
+}
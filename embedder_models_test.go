@@ -0,0 +1,73 @@
+package unstructured
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEmbedderModels(t *testing.T) {
+	t.Parallel()
+
+	seen := make(map[EmbedderModel]bool)
+
+	for _, m := range EmbedderModels("") {
+		if seen[m.Model] {
+			t.Errorf("model %s is listed twice", m.Model)
+		}
+
+		seen[m.Model] = true
+
+		// the catalog agrees with the models an embedder accepts.
+		if err := (&Embedder{Subtype: m.Subtype, ModelName: m.Model}).ValidateModel(); err != nil {
+			t.Errorf("expected %s to be a valid %s model: %v", m.Model, m.Subtype, err)
+		}
+
+		if m.Dimensions <= 0 || m.MaxInputTokens <= 0 || m.Distance == "" {
+			t.Errorf("expected %s to be fully described, got %+v", m.Model, m)
+		}
+	}
+
+	var multimodal []EmbedderModel
+
+	for _, m := range EmbedderModels("") {
+		if m.Multimodal {
+			multimodal = append(multimodal, m.Model)
+		}
+	}
+
+	if err := errors.Join(
+		eq("models", len(seen), 17),
+		eq("voyage models", len(EmbedderModels(EmbedderSubtypeVoyageAI)), 8),
+		eq("unknown subtype models", len(EmbedderModels("unknown")), 0),
+		eqs("multimodal", multimodal, []EmbedderModel{EmbedderModelBedrockTitanEmbedImageV1, EmbedderModelVoyageAIMultimodal3}),
+	); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEmbedderModelInfo(t *testing.T) {
+	t.Parallel()
+
+	m, ok := (&Embedder{Subtype: EmbedderSubtypeBedrock, ModelName: EmbedderModelBedrockCohereEmbedEnglish}).ModelInfo()
+	if !ok {
+		t.Fatal("expected the model to be known")
+	}
+
+	if err := errors.Join(
+		eq("dimensions", m.Dimensions, 1024),
+		eq("max characters", m.MaxCharacters(), 1536),
+		eq("default fits", m.CheckMaxCharacters(0) == nil, true),
+		eq("1500 fits", m.CheckMaxCharacters(1500) == nil, true),
+		eq("2000 fits", m.CheckMaxCharacters(2000) == nil, false),
+	); err != nil {
+		t.Error(err)
+	}
+
+	if _, ok := (&Embedder{Subtype: EmbedderSubtypeVoyageAI, ModelName: EmbedderModelBedrockCohereEmbedEnglish}).ModelInfo(); ok {
+		t.Error("expected a model of another subtype not to be found")
+	}
+
+	if _, ok := LookupEmbedderModel("unknown"); ok {
+		t.Error("expected an unknown model not to be found")
+	}
+}
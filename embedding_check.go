@@ -0,0 +1,88 @@
+package unstructured
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Errors reported by [WorkflowNodes.CheckEmbedding].
+var (
+	// ErrMissingEmbedder is reported when a workflow writes to a vector store without embedding its chunks.
+	ErrMissingEmbedder = errors.New("workflow has no embedder")
+	// ErrDimensionMismatch is reported when an embedder produces vectors of another dimension than the destination stores.
+	ErrDimensionMismatch = errors.New("embedding dimension mismatch")
+)
+
+// vectorDestinations are the connector types of destinations that store embeddings.
+var vectorDestinations = []string{
+	ConnectorTypeAstraDB,
+	ConnectorTypeAzureAISearch,
+	ConnectorTypeMilvus,
+	ConnectorTypePinecone,
+	ConnectorTypeQdrantCloud,
+	ConnectorTypeWeaviateCloud,
+}
+
+// CheckEmbedding checks that the embeddings of the workflow fit the destination it writes to:
+// workflows writing to a vector store, like Pinecone or Qdrant, must have an embedder,
+// and if dimensions is not zero, its model must produce vectors of that dimension.
+// The destination configs of the API do not state the dimension of their index,
+// so it is up to the caller to pass it. Models that are not in [EmbedderModels] are not checked.
+func (w WorkflowNodes) CheckEmbedding(destination DestinationConfig, dimensions int) (err error) {
+	if destination == nil || !slices.Contains(vectorDestinations, destination.Type()) {
+		return nil
+	}
+
+	found := false
+
+	for _, node := range w {
+		var embedder *Embedder
+
+		switch node := node.(type) {
+		case *Embedder:
+			embedder = node
+		case Embedder:
+			embedder = &node
+		default:
+			continue
+		}
+
+		found = true
+
+		model, ok := embedder.ModelInfo()
+		if !ok || dimensions == 0 || model.Dimensions == dimensions {
+			continue
+		}
+
+		err = errors.Join(err, fmt.Errorf("%w: %s produces vectors of %d dimensions, but the %s destination stores %d",
+			ErrDimensionMismatch, model.Model, model.Dimensions, destination.Type(), dimensions))
+	}
+
+	if !found {
+		err = errors.Join(err, fmt.Errorf("%w: the %s destination stores vectors", ErrMissingEmbedder, destination.Type()))
+	}
+
+	return err
+}
+
+// CheckEmbeddingRequest represents the request to check the embeddings of workflow nodes against a destination connector.
+type CheckEmbeddingRequest struct {
+	WorkflowNodes []WorkflowNode
+	DestinationID string
+}
+
+// CheckEmbedding gets the destination connector and checks that the workflow nodes embed their chunks
+// the way it stores them, see [WorkflowNodes.CheckEmbedding]. Call it with the nodes and destination
+// of a [CreateWorkflowRequest] or [UpdateWorkflowRequest] before sending it.
+// As with [WorkflowNodes.CheckEmbedding], dimensions is the dimension of the vectors the destination's index stores,
+// or zero if it is not known.
+func (c *Client) CheckEmbedding(ctx context.Context, in CheckEmbeddingRequest, dimensions int) error {
+	destination, err := c.GetDestination(ctx, in.DestinationID)
+	if err != nil {
+		return err
+	}
+
+	return WorkflowNodes(in.WorkflowNodes).CheckEmbedding(destination.Config, dimensions)
+}
@@ -0,0 +1,78 @@
+package unstructured
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckEmbedding(t *testing.T) {
+	t.Parallel()
+
+	pinecone := &PineconeDestinationConnectorConfig{IndexName: "docs", APIKey: "key", Namespace: "default"}
+	embed := func(model EmbedderModel) WorkflowNodes {
+		return WorkflowNodes{
+			&PartitionerFast{},
+			&ChunkerTitle{},
+			&Embedder{Subtype: EmbedderSubtypeBedrock, ModelName: model},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		nodes       WorkflowNodes
+		destination DestinationConfig
+		dimensions  int
+		want        error
+	}{
+		{"matching dimensions", embed(EmbedderModelBedrockTitanEmbedTextV2), pinecone, 1024, nil},
+		{"unknown dimensions", embed(EmbedderModelBedrockTitanEmbedTextV1), pinecone, 0, nil},
+		{"unknown model", embed("custom"), pinecone, 1536, nil},
+		{"mismatched dimensions", embed(EmbedderModelBedrockTitanEmbedTextV2), pinecone, 1536, ErrDimensionMismatch},
+		{"missing embedder", WorkflowNodes{&PartitionerFast{}, &ChunkerTitle{}}, &QdrantCloudDestinationConnectorConfig{}, 0, ErrMissingEmbedder},
+		{"not a vector store", WorkflowNodes{&PartitionerFast{}}, &S3ConnectorConfig{}, 1536, nil},
+		{"no destination", WorkflowNodes{&PartitionerFast{}}, nil, 0, nil},
+	} {
+		err := tt.nodes.CheckEmbedding(tt.destination, tt.dimensions)
+		if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestClientCheckEmbedding(t *testing.T) {
+	t.Parallel()
+
+	client, _ := fakeclient(t)
+	ctx := testContext(t)
+
+	destination, err := client.CreateDestination(ctx, CreateDestinationRequest{
+		Name:   "vectors",
+		Config: &WeaviateDestinationConnectorConfig{ClusterURL: "https://vectors.weaviate.cloud", APIKey: "key"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create destination: %v", err)
+	}
+
+	in := CheckEmbeddingRequest{
+		WorkflowNodes: []WorkflowNode{
+			&PartitionerFast{},
+			&ChunkerTitle{},
+			&Embedder{Subtype: EmbedderSubtypeAzureOpenAI, ModelName: EmbedderModelAzureOpenAITextEmbedding3Large},
+		},
+		DestinationID: destination.ID,
+	}
+
+	if err := client.CheckEmbedding(ctx, in, 1536); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("expected a dimension mismatch, got %v", err)
+	}
+
+	if err := client.CheckEmbedding(ctx, in, 3072); err != nil {
+		t.Errorf("expected the embedding to fit, got %v", err)
+	}
+
+	in.DestinationID = "missing"
+
+	if err := client.CheckEmbedding(ctx, in, 3072); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a missing destination to be reported, got %v", err)
+	}
+}
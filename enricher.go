@@ -3,7 +3,7 @@ package unstructured
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
+	"slices"
 )
 
 // Enricher is a node that enriches text.
@@ -32,18 +32,93 @@ const (
 	EnrichmentTypeTableBedrock EnrichmentType = "bedrock_table_description"
 )
 
+// EnrichmentModality is the kind of content an enrichment works on.
+type EnrichmentModality string
+
+// EnrichmentModality constants.
+const (
+	EnrichmentModalityImage      EnrichmentModality = "image"
+	EnrichmentModalityTable      EnrichmentModality = "table"
+	EnrichmentModalityTable2HTML EnrichmentModality = "table2html"
+	EnrichmentModalityNER        EnrichmentModality = "ner"
+)
+
+// EnrichmentInfo describes an enrichment type, see [Enrichments].
+type EnrichmentInfo struct {
+	Type     EnrichmentType     `json:"type"`
+	Provider Provider           `json:"provider"`
+	Modality EnrichmentModality `json:"modality"`
+}
+
+var enrichments = []EnrichmentInfo{
+	{EnrichmentTypeImageOpenAI, ProviderOpenAI, EnrichmentModalityImage},
+	{EnrichmentTypeTableOpenAI, ProviderOpenAI, EnrichmentModalityTable},
+	{EnrichmentTypeTable2HTMLOpenAI, ProviderOpenAI, EnrichmentModalityTable2HTML},
+	{EnrichmentTypeNEROpenAI, ProviderOpenAI, EnrichmentModalityNER},
+
+	{EnrichmentTypeImageAnthropic, ProviderAnthropic, EnrichmentModalityImage},
+	{EnrichmentTypeTableAnthropic, ProviderAnthropic, EnrichmentModalityTable},
+	{EnrichmentTypeNERAnthropic, ProviderAnthropic, EnrichmentModalityNER},
+
+	{EnrichmentTypeImageBedrock, ProviderBedrock, EnrichmentModalityImage},
+	{EnrichmentTypeTableBedrock, ProviderBedrock, EnrichmentModalityTable},
+}
+
+// Enrichments returns the enrichment types of the given provider and modality.
+// An empty provider or modality matches all of them.
+func Enrichments(provider Provider, modality EnrichmentModality) []EnrichmentInfo {
+	var out []EnrichmentInfo
+
+	for _, e := range enrichments {
+		if (provider == "" || e.Provider == provider) && (modality == "" || e.Modality == modality) {
+			out = append(out, e)
+		}
+	}
+
+	return out
+}
+
+// LookupEnrichment returns the description of the given enrichment type, if it is known.
+func LookupEnrichment(t EnrichmentType) (EnrichmentInfo, bool) {
+	i := slices.IndexFunc(enrichments, func(e EnrichmentInfo) bool { return e.Type == t })
+	if i < 0 {
+		return EnrichmentInfo{}, false
+	}
+
+	return enrichments[i], true
+}
+
 var _ WorkflowNode = new(Enricher)
 
-func (e Enricher) isNode()       {}
-func (e Enricher) isImage() bool { return strings.Contains(string(e.Subtype), "image") }
-func (e Enricher) isTable() bool { return strings.Contains(string(e.Subtype), "table") }
-func (e Enricher) isNER() bool   { return strings.Contains(string(e.Subtype), "ner") }
+func (e Enricher) isNode() {}
+
+func (e Enricher) modality() EnrichmentModality {
+	info, _ := LookupEnrichment(e.Subtype)
+	return info.Modality
+}
+
+func (e Enricher) isImage() bool { return e.modality() == EnrichmentModalityImage }
+func (e Enricher) isNER() bool   { return e.modality() == EnrichmentModalityNER }
+
+// isTable reports whether the enrichment works on tables, whether it describes them or converts them to HTML.
+func (e Enricher) isTable() bool {
+	return e.modality() == EnrichmentModalityTable || e.modality() == EnrichmentModalityTable2HTML
+}
+
+// ValidateSubtype validates that the enrichment type is known.
+func (e *Enricher) ValidateSubtype() error {
+	if _, ok := LookupEnrichment(e.Subtype); !ok {
+		return fmt.Errorf("unknown enrichment type: %s", e.Subtype)
+	}
+
+	return nil
+}
 
 // MarshalJSON implements the json.Marshaler interface.
 func (e Enricher) MarshalJSON() ([]byte, error) {
 	var settings json.RawMessage
 
-	if e.NERPromptOverride != "" && (e.Subtype == EnrichmentTypeNERAnthropic || e.Subtype == EnrichmentTypeNEROpenAI) {
+	if e.NERPromptOverride != "" && e.isNER() {
 		nested := struct {
 			PromptOverride struct {
 				Prompt struct {
@@ -77,13 +152,26 @@ func (e Enricher) MarshalJSON() ([]byte, error) {
 
 func unmarshalEnricher(header header) (WorkflowNode, error) {
 	enricher := &Enricher{
-		ID:   header.ID,
-		Name: header.Name,
+		ID:      header.ID,
+		Name:    header.Name,
+		Subtype: EnrichmentType(header.Subtype),
 	}
 
-	if err := json.Unmarshal(header.Settings, enricher); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal enricher: %w", err)
+	var nested struct {
+		PromptOverride struct {
+			Prompt struct {
+				User string `json:"user"`
+			} `json:"prompt"`
+		} `json:"prompt_interface_overrides"`
 	}
 
+	if len(header.Settings) > 0 {
+		if err := json.Unmarshal(header.Settings, &nested); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal enricher: %w", err)
+		}
+	}
+
+	enricher.NERPromptOverride = nested.PromptOverride.Prompt.User
+
 	return enricher, nil
 }
@@ -1,19 +1,29 @@
 package unstructured
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 )
 
 // HTTPValidationError represents the structure of validation error responses
 // returned by the API when a 422 status code is returned.
+// The locations of errors returned by the client's methods map onto the fields of the request, see [HTTPValidationError.FieldErrors].
 type HTTPValidationError struct {
 	Detail []*ValidationError `json:"detail"`
+
+	// request is the Go value the rejected request body was encoded from.
+	request any
 }
 
 func (e *HTTPValidationError) Error() string {
-	errs := make([]error, len(e.Detail))
-	for i, err := range e.Detail {
+	fields := e.FieldErrors()
+
+	errs := make([]error, len(fields))
+	for i, err := range fields {
 		errs[i] = err
 	}
 
@@ -35,15 +45,173 @@ func (e *ValidationError) Error() string {
 	return fmt.Sprintf("%s at %v: %s", e.Type, e.Location, e.Message)
 }
 
+// Sentinel errors that an [APIError] matches with [errors.Is], depending on its status code.
+var (
+	// ErrUnauthorized matches errors with status 401, when the API key is missing or invalid.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrForbidden matches errors with status 403.
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrNotFound matches errors with status 404.
+	ErrNotFound = errors.New("not found")
+
+	// ErrConflict matches errors with status 409.
+	ErrConflict = errors.New("conflict")
+
+	// ErrRateLimited matches errors with status 429. See [APIError.RetryAfter] for when to try again.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrServer matches errors with a 5xx status.
+	ErrServer = errors.New("server error")
+)
+
 // APIError represents an error returned by the API when a non-200 status code is returned.
+// It matches the sentinel error for its status code, like [ErrNotFound], with [errors.Is].
 type APIError struct {
 	Code int
-	Err  error
+
+	// Err is an [*HTTPValidationError] for 422 responses that describe what is invalid,
+	// and otherwise an error with the detail message or, if there is none, the response body.
+	Err error
+
+	// Detail is the "detail" message of the response, if it has one.
+	Detail string
+
+	// RequestID is the ID the API assigned to the request, see [HeaderRequestID].
+	RequestID string
+
+	Method string
+	URL    string
+
+	// RetryAfter is how long the API asked to wait before trying again, from the Retry-After header.
+	RetryAfter time.Duration
+}
+
+// newAPIError returns the error for a response with an error status and the given body.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	e := APIError{
+		Code:      resp.StatusCode,
+		RequestID: resp.Header.Get(HeaderRequestID),
+	}
+
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		e.URL = resp.Request.URL.String()
+	}
+
+	if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+		e.RetryAfter = d
+	}
+
+	var detail struct {
+		Detail json.RawMessage `json:"detail"`
+	}
+
+	if err := json.Unmarshal(body, &detail); err == nil {
+		_ = json.Unmarshal(detail.Detail, &e.Detail)
+	}
+
+	// Handle 422 validation errors specifically
+	if resp.StatusCode == http.StatusUnprocessableEntity && e.Detail == "" {
+		var validationErr HTTPValidationError
+		if err := json.Unmarshal(body, &validationErr); err == nil && len(validationErr.Detail) > 0 {
+			e.Err = &validationErr
+			return &e
+		}
+	}
+
+	if e.Detail != "" {
+		e.Err = errors.New(e.Detail)
+	} else {
+		e.Err = errors.New(string(body))
+	}
+
+	return &e
 }
 
 // Error returns a string representation of the API error.
 func (e *APIError) Error() string {
-	return fmt.Sprintf("an API error occurred: [%d] %s", e.Code, e.Err.Error())
+	if e.Method == "" {
+		return fmt.Sprintf("an API error occurred: [%d] %s", e.Code, e.Err.Error())
+	}
+
+	return fmt.Sprintf("an API error occurred: [%d] %s %s: %s", e.Code, e.Method, e.URL, e.Err.Error())
 }
 
 func (e *APIError) Unwrap() error { return e.Err }
+
+// Is reports whether target is the sentinel error for the status code of e.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.Code == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.Code == http.StatusForbidden
+	case ErrNotFound:
+		return e.Code == http.StatusNotFound
+	case ErrConflict:
+		return e.Code == http.StatusConflict
+	case ErrRateLimited:
+		return e.Code == http.StatusTooManyRequests
+	case ErrServer:
+		return e.Code >= http.StatusInternalServerError
+	}
+
+	return false
+}
+
+// ConnectionCheckError is returned when a connection check for a connector finishes with a failure.
+type ConnectionCheckError struct {
+	ConnectorID string
+	Reason      string
+}
+
+// Error returns a string representation of the connection check failure.
+func (e *ConnectionCheckError) Error() string {
+	return fmt.Sprintf("connection check failed for connector %s: %s", e.ConnectorID, e.Reason)
+}
+
+// DependentWorkflowsError is returned when a connector cannot be deleted because workflows still reference it.
+type DependentWorkflowsError struct {
+	ConnectorID string
+	Kind        ConnectorKind
+	Workflows   []Workflow
+}
+
+// Error returns a string representation of the dependent workflows error.
+func (e *DependentWorkflowsError) Error() string {
+	names := make([]string, len(e.Workflows))
+	for i, wf := range e.Workflows {
+		names[i] = fmt.Sprintf("%q (%s)", wf.Name, wf.ID)
+	}
+
+	return fmt.Sprintf("%s %s is referenced by %d workflows: %s; delete them or update them to use another %s first",
+		e.Kind, e.ConnectorID, len(e.Workflows), strings.Join(names, ", "), e.Kind)
+}
+
+// StaleConnectorError is returned when a patch is rejected because the connector
+// was modified after the version the caller expected.
+type StaleConnectorError struct {
+	ID       string
+	Expected time.Time
+	Actual   time.Time
+}
+
+// Error returns a string representation of the stale connector error.
+func (e *StaleConnectorError) Error() string {
+	return fmt.Sprintf("connector %s was updated at %s, expected %s",
+		e.ID, e.Actual.Format(time.RFC3339Nano), e.Expected.Format(time.RFC3339Nano))
+}
+
+// MaskedSecretsError is returned when a patch would send back credentials that the API masked when the connector was read.
+// The patch must supply every listed field.
+type MaskedSecretsError struct {
+	ID     string
+	Fields []string
+}
+
+// Error returns a string representation of the masked secrets error.
+func (e *MaskedSecretsError) Error() string {
+	return fmt.Sprintf("connector %s has masked credentials that the patch must supply: %s", e.ID, strings.Join(e.Fields, ", "))
+}
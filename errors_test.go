@@ -0,0 +1,91 @@
+package unstructured
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIErrorSentinels(t *testing.T) {
+	t.Parallel()
+
+	for code, want := range map[int]error{
+		http.StatusUnauthorized:        ErrUnauthorized,
+		http.StatusForbidden:           ErrForbidden,
+		http.StatusNotFound:            ErrNotFound,
+		http.StatusConflict:            ErrConflict,
+		http.StatusTooManyRequests:     ErrRateLimited,
+		http.StatusInternalServerError: ErrServer,
+		http.StatusBadGateway:          ErrServer,
+	} {
+		err := error(&APIError{Code: code, Err: errors.New("oops")})
+
+		for _, sentinel := range []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrConflict, ErrRateLimited, ErrServer} {
+			if got := errors.Is(err, sentinel); got != (sentinel == want) {
+				t.Errorf("expected errors.Is(%d, %v) to be %t, got %t", code, sentinel, sentinel == want, got)
+			}
+		}
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	t.Parallel()
+
+	client, fake := fakeclient(t)
+	ctx := testContext(t)
+
+	_, err := client.GetJob(ctx, "missing")
+
+	var apierr *APIError
+	if !errors.As(err, &apierr) {
+		t.Fatalf("expected error to be an %T, got %T", apierr, err)
+	}
+
+	if err := errors.Join(
+		eq("is not found", errors.Is(err, ErrNotFound), true),
+		eq("detail", apierr.Detail, "Job with id missing not found"),
+		eq("method", apierr.Method, http.MethodGet),
+		eq("url", strings.HasSuffix(apierr.URL, "/api/v1/jobs/missing"), true),
+		eq("has request id", apierr.RequestID != "", true),
+		eq("message", strings.Contains(err.Error(), "[404] GET "), true),
+	); err != nil {
+		t.Error(err)
+	}
+
+	// downloads report errors like every other method.
+	fake.Advance(time.Hour)
+
+	if _, err := client.DownloadJob(ctx, DownloadJobRequest{JobID: "missing"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected downloading a missing job to fail with %v, got %v", ErrNotFound, err)
+	}
+}
+
+func TestAPIErrorRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	client, mux := testclient(t)
+
+	mux.GetJob = func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Retry-After", "7")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"detail":"slow down"}`))
+	}
+
+	_, err := client.GetJob(testContext(t), "job")
+
+	var apierr *APIError
+	if !errors.As(err, &apierr) {
+		t.Fatalf("expected error to be an %T, got %T", apierr, err)
+	}
+
+	if err := errors.Join(
+		eq("is rate limited", errors.Is(err, ErrRateLimited), true),
+		eq("retry after", apierr.RetryAfter, 7*time.Second),
+		eq("detail", apierr.Detail, "slow down"),
+	); err != nil {
+		t.Error(err)
+	}
+}
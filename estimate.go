@@ -0,0 +1,167 @@
+package unstructured
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// Rate is what a workflow node costs, and how long it takes, per page.
+type Rate struct {
+	PerPage     float64       `json:"per_page"`
+	TimePerPage time.Duration `json:"time_per_page"`
+}
+
+// Pricing is the table of rates that [EstimateRun] applies to the nodes of a workflow.
+type Pricing struct {
+	Currency string `json:"currency"`
+
+	// Rates maps node types and subtypes, like "partition/vlm" or "embed/bedrock", to their rate.
+	// Nodes whose subtype has no rate take the rate of their type, like "embed".
+	// Enrichment nodes have the type "prompter".
+	Rates map[string]Rate `json:"rates"`
+}
+
+// DefaultPricing returns a pricing table with illustrative rates in USD.
+// Prices and speeds depend on the plan of the account and on the documents, so copy and adjust it
+// with the rates of your own contract and measured runs.
+func DefaultPricing() Pricing {
+	return Pricing{
+		Currency: "USD",
+		Rates: map[string]Rate{
+			nodeTypePartition + "/" + PartitionerStrategyFast:  {PerPage: 0.001, TimePerPage: 50 * time.Millisecond},
+			nodeTypePartition + "/" + PartitionerStrategyHiRes: {PerPage: 0.01, TimePerPage: time.Second},
+			nodeTypePartition + "/" + PartitionerStrategyAuto:  {PerPage: 0.01, TimePerPage: time.Second},
+			nodeTypePartition + "/" + PartitionerStrategyVLM:   {PerPage: 0.03, TimePerPage: 5 * time.Second},
+			nodeTypeEnrich: {PerPage: 0.01, TimePerPage: 2 * time.Second},
+			nodeTypeChunk:  {PerPage: 0, TimePerPage: 5 * time.Millisecond},
+			nodeTypeEmbed:  {PerPage: 0.0005, TimePerPage: 50 * time.Millisecond},
+		},
+	}
+}
+
+// FileEstimate is the page count of an input file.
+type FileEstimate struct {
+	Name  string   `json:"name"`
+	Kind  FileKind `json:"kind"`
+	Pages int      `json:"pages"`
+
+	// Exact reports whether the pages were counted, rather than estimated from the size of the file.
+	Exact bool `json:"exact"`
+}
+
+// NodeEstimate is the estimated cost and processing time of a workflow node, for all input files.
+type NodeEstimate struct {
+	Name     string        `json:"name"`
+	Type     string        `json:"type"`
+	Subtype  string        `json:"subtype"`
+	Cost     float64       `json:"cost"`
+	Duration time.Duration `json:"duration"`
+
+	// Priced reports whether the pricing table has a rate for the node. Nodes without one are estimated at zero.
+	Priced bool `json:"priced"`
+}
+
+// RunEstimate is the estimated cost and processing time of running a workflow on a set of files.
+type RunEstimate struct {
+	Currency string         `json:"currency"`
+	Files    []FileEstimate `json:"files"`
+	Nodes    []NodeEstimate `json:"nodes"`
+	Pages    int            `json:"pages"`
+	Cost     float64        `json:"cost"`
+
+	// Duration is the processing time of all pages by all nodes, one after the other.
+	// Since the API processes files concurrently, a run usually takes less.
+	Duration time.Duration `json:"duration"`
+}
+
+// EstimateRun estimates what running a workflow with the given nodes on the files costs and how long it takes,
+// by counting the pages of the files and applying the rates of the pricing table to each node.
+// Pages are counted in PDF files, Word documents and PowerPoint presentations, images count as one page,
+// and the pages of other files are estimated from their size. Files are read to the end.
+func EstimateRun(nodes []WorkflowNode, files []File, pricing Pricing) (*RunEstimate, error) {
+	estimate := &RunEstimate{Currency: pricing.Currency}
+
+	for _, f := range files {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", f.Name(), err)
+		}
+
+		pages, kind, exact := countPages(f.Name(), data)
+
+		estimate.Files = append(estimate.Files, FileEstimate{Name: f.Name(), Kind: kind, Pages: pages, Exact: exact})
+		estimate.Pages += pages
+	}
+
+	for _, node := range nodes {
+		data, err := json.Marshal(node)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect node: %w", err)
+		}
+
+		var h header
+		if err := json.Unmarshal(data, &h); err != nil {
+			return nil, fmt.Errorf("failed to inspect node: %w", err)
+		}
+
+		n := NodeEstimate{Name: h.Name, Type: h.Type, Subtype: h.Subtype}
+
+		rate, ok := pricing.Rates[h.Type+"/"+h.Subtype]
+		if !ok {
+			rate, ok = pricing.Rates[h.Type]
+		}
+
+		if ok {
+			n.Priced = true
+			n.Cost = rate.PerPage * float64(estimate.Pages)
+			n.Duration = rate.TimePerPage * time.Duration(estimate.Pages)
+		}
+
+		estimate.Nodes = append(estimate.Nodes, n)
+		estimate.Cost += n.Cost
+		estimate.Duration += n.Duration
+	}
+
+	return estimate, nil
+}
+
+// WriteTo writes the estimate as a breakdown by file and by node to w.
+// It implements the [io.WriterTo] interface.
+func (e *RunEstimate) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	tw := tabwriter.NewWriter(cw, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "FILE\tKIND\tPAGES")
+
+	for _, f := range e.Files {
+		pages := fmt.Sprint(f.Pages)
+		if !f.Exact {
+			pages = "~" + pages
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", f.Name, f.Kind, pages)
+	}
+
+	fmt.Fprintln(tw)
+	fmt.Fprintf(tw, "NODE\tTYPE\tCOST (%s)\tDURATION\n", e.Currency)
+
+	for _, n := range e.Nodes {
+		cost := fmt.Sprintf("%.2f", n.Cost)
+		if !n.Priced {
+			cost = "unpriced"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s/%s\t%s\t%s\n", n.Name, n.Type, n.Subtype, cost, n.Duration.Round(time.Second))
+	}
+
+	fmt.Fprintf(tw, "TOTAL\t%d pages\t%.2f\t%s\n", e.Pages, e.Cost, e.Duration.Round(time.Second))
+
+	if err := tw.Flush(); err != nil {
+		return cw.n, fmt.Errorf("failed to write run estimate: %w", err)
+	}
+
+	return cw.n, nil
+}
@@ -0,0 +1,168 @@
+package unstructured
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"io"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// FileKind is how the pages of a file were counted, see [FileEstimate].
+type FileKind string
+
+// FileKind constants.
+const (
+	// FileKindPDF is a PDF document, whose pages are counted from its page tree.
+	FileKindPDF FileKind = "pdf"
+	// FileKindImage is an image, which is partitioned as one page.
+	FileKindImage FileKind = "image"
+	// FileKindOffice is a Word document or a PowerPoint presentation, whose pages or slides are counted.
+	FileKindOffice FileKind = "office"
+	// FileKindOther is any other file, whose pages are estimated from its size.
+	FileKindOther FileKind = "other"
+)
+
+// bytesPerPage is the size of a page of text, to estimate the pages of files that have no pages of their own.
+const bytesPerPage = 3000
+
+var imageExtensions = []string{".bmp", ".gif", ".heic", ".jpeg", ".jpg", ".png", ".tif", ".tiff", ".webp"}
+
+// countPages counts the pages of the named file, and reports whether the count is exact.
+func countPages(name string, data []byte) (int, FileKind, bool) {
+	ext := strings.ToLower(path.Ext(name))
+
+	switch {
+	case ext == ".pdf" || bytes.HasPrefix(data, []byte("%PDF-")):
+		if n := countPDFPages(data); n > 0 {
+			return n, FileKindPDF, true
+		}
+
+	case slices.Contains(imageExtensions, ext):
+		return 1, FileKindImage, true
+
+	case ext == ".docx" || ext == ".pptx":
+		if n := countOfficePages(data); n > 0 {
+			return n, FileKindOffice, true
+		}
+	}
+
+	return max(1, (len(data)+bytesPerPage-1)/bytesPerPage), FileKindOther, false
+}
+
+var (
+	pdfPages  = regexp.MustCompile(`/Type\s*/Pages\b`)
+	pdfCount  = regexp.MustCompile(`/Count\s+(\d+)`)
+	pdfStream = regexp.MustCompile(`(?s)/FlateDecode.*?stream\r?\n`)
+)
+
+// countPDFPages returns the page count of the root of the page tree of a PDF, which is the largest count
+// of the page tree nodes. Page tree nodes may be in compressed object streams, which are inflated to find them.
+func countPDFPages(data []byte) int {
+	pages := countPageTree(data)
+
+	for _, loc := range pdfStream.FindAllIndex(data, -1) {
+		end := bytes.Index(data[loc[1]:], []byte("endstream"))
+		if end < 0 {
+			continue
+		}
+
+		zr, err := zlib.NewReader(bytes.NewReader(data[loc[1] : loc[1]+end]))
+		if err != nil {
+			continue
+		}
+
+		inflated, _ := io.ReadAll(io.LimitReader(zr, 16<<20))
+		_ = zr.Close()
+
+		pages = max(pages, countPageTree(inflated))
+	}
+
+	return pages
+}
+
+// countPageTree returns the largest /Count of the page tree nodes in data.
+func countPageTree(data []byte) int {
+	pages := 0
+
+	for _, loc := range pdfPages.FindAllIndex(data, -1) {
+		dict := enclosingDict(data, loc[0])
+
+		if m := pdfCount.FindSubmatch(dict); m != nil {
+			n, _ := strconv.Atoi(string(m[1]))
+			pages = max(pages, n)
+		}
+	}
+
+	return pages
+}
+
+// enclosingDict returns the dictionary, between << and >>, that contains the offset i.
+func enclosingDict(data []byte, i int) []byte {
+	start := bytes.LastIndex(data[:i], []byte("<<"))
+	if start < 0 {
+		return nil
+	}
+
+	depth := 0
+
+	for j := start; j+1 < len(data); j++ {
+		switch {
+		case data[j] == '<' && data[j+1] == '<':
+			depth++
+			j++
+		case data[j] == '>' && data[j+1] == '>':
+			depth--
+			j++
+
+			if depth == 0 {
+				return data[start : j+1]
+			}
+		}
+	}
+
+	return data[start:]
+}
+
+var docxPages = regexp.MustCompile(`<Pages>(\d+)</Pages>`)
+
+// countOfficePages returns the pages of a Word document, as last saved in its properties,
+// or the slides of a PowerPoint presentation.
+func countOfficePages(data []byte) int {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return 0
+	}
+
+	slides := 0
+
+	for _, f := range zr.File {
+		if dir, name := path.Split(f.Name); dir == "ppt/slides/" && strings.HasSuffix(name, ".xml") {
+			slides++
+		}
+
+		if f.Name != "docProps/app.xml" {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			continue
+		}
+
+		props, _ := io.ReadAll(io.LimitReader(rc, 1<<20))
+		_ = rc.Close()
+
+		if m := docxPages.FindSubmatch(props); m != nil {
+			if n, _ := strconv.Atoi(string(m[1])); n > 0 {
+				return n
+			}
+		}
+	}
+
+	return slides
+}
@@ -0,0 +1,141 @@
+package unstructured
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEstimateRun(t *testing.T) {
+	t.Parallel()
+
+	pdf, err := os.Open("test/testdata/1706.03762v7.pdf")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %v", err)
+	}
+
+	t.Cleanup(func() { _ = pdf.Close() })
+
+	files := []File{
+		pdf,
+		&FileBytes{Filename: "scan.PNG", Bytes: strings.NewReader("\x89PNG")},
+		&FileBytes{Filename: "report.docx", Bytes: bytes.NewReader(office(t, map[string]string{
+			"word/document.xml": "<w:document/>",
+			"docProps/app.xml":  "<Properties><Pages>4</Pages></Properties>",
+		}))},
+		&FileBytes{Filename: "deck.pptx", Bytes: bytes.NewReader(office(t, map[string]string{
+			"ppt/slides/slide1.xml":            "<p:sld/>",
+			"ppt/slides/slide2.xml":            "<p:sld/>",
+			"ppt/slides/_rels/slide1.xml.rels": "<Relationships/>",
+		}))},
+		&FileBytes{Filename: "notes.txt", Bytes: strings.NewReader(strings.Repeat("x", 7000))},
+	}
+
+	pricing := DefaultPricing()
+	pricing.Rates = map[string]Rate{
+		"partition/vlm": {PerPage: 0.03, TimePerPage: 5 * time.Second},
+		"partition":     {PerPage: 0.01, TimePerPage: time.Second},
+		"embed":         {PerPage: 0.001, TimePerPage: 100 * time.Millisecond},
+	}
+
+	estimate, err := EstimateRun([]WorkflowNode{
+		&PartitionerVLM{Name: "Partitioner", Provider: ProviderAnthropic, Model: ModelClaude37Sonnet},
+		&ChunkerTitle{Name: "Chunker"},
+		&Embedder{Name: "Embedder", Subtype: EmbedderSubtypeBedrock, ModelName: EmbedderModelBedrockTitanEmbedTextV2},
+	}, files, pricing)
+	if err != nil {
+		t.Fatalf("failed to estimate run: %v", err)
+	}
+
+	// 15 pages of PDF, an image, 4 pages of Word, 2 slides and about 3 pages of text.
+	if err := errors.Join(
+		eq("files", len(estimate.Files), 5),
+		eq("pdf", estimate.Files[0], FileEstimate{Name: pdf.Name(), Kind: FileKindPDF, Pages: 15, Exact: true}),
+		eq("image", estimate.Files[1], FileEstimate{Name: "scan.PNG", Kind: FileKindImage, Pages: 1, Exact: true}),
+		eq("docx", estimate.Files[2], FileEstimate{Name: "report.docx", Kind: FileKindOffice, Pages: 4, Exact: true}),
+		eq("pptx", estimate.Files[3], FileEstimate{Name: "deck.pptx", Kind: FileKindOffice, Pages: 2, Exact: true}),
+		eq("text", estimate.Files[4], FileEstimate{Name: "notes.txt", Kind: FileKindOther, Pages: 3}),
+		eq("pages", estimate.Pages, 25),
+		eq("partitioner", estimate.Nodes[0], NodeEstimate{
+			Name: "Partitioner", Type: "partition", Subtype: "vlm", Cost: 0.75, Duration: 125 * time.Second, Priced: true,
+		}),
+		eq("chunker priced", estimate.Nodes[1].Priced, false),
+		eq("embedder duration", estimate.Nodes[2].Duration, 2500*time.Millisecond),
+		eq("duration", estimate.Duration, 127500*time.Millisecond),
+		eq("cost", estimate.Cost > 0.774 && estimate.Cost < 0.776, true),
+	); err != nil {
+		t.Error(err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := estimate.WriteTo(&buf); err != nil {
+		t.Fatalf("failed to write estimate: %v", err)
+	}
+
+	lines := strings.Split(buf.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+
+	for _, want := range []string{"notes.txt other ~3", "Chunker chunk/chunk_by_title unpriced 0s", "TOTAL 25 pages 0.78 2m8s"} {
+		if !slices.Contains(lines, want) {
+			t.Errorf("expected the breakdown to contain %q, got:\n%s", want, buf.String())
+		}
+	}
+}
+
+// office returns a zip archive of the given files, like Word and PowerPoint files are.
+func office(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	zw := zip.NewWriter(&buf)
+
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+
+		_, _ = w.Write([]byte(content))
+	}
+
+	if err := zw.Close(); err != nil {
+		t.Fatalf("failed to close archive: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestCountPDFPages(t *testing.T) {
+	t.Parallel()
+
+	var objects bytes.Buffer
+
+	zw := zlib.NewWriter(&objects)
+	_, _ = zw.Write([]byte("<< /Kids [3 0 R 4 0 R] /Count 2 /Type /Pages /Resources << /Font << >> >> >>"))
+	_ = zw.Close()
+
+	for _, tt := range []struct {
+		name string
+		pdf  string
+		want int
+	}{
+		{"plain", "%PDF-1.4\n1 0 obj << /Type /Pages /Count 3 /Kids [] >> endobj", 3},
+		{"outlines", "%PDF-1.4\n1 0 obj << /Type /Outlines /Count 40 >> endobj\n2 0 obj << /Count 7 /Type /Pages >> endobj", 7},
+		{"object stream", "%PDF-1.5\n5 0 obj << /Type /ObjStm /Filter /FlateDecode /Length 80 >>\nstream\n" +
+			objects.String() + "\nendstream endobj", 2},
+		{"no page tree", "%PDF-1.4\n", 0},
+	} {
+		if got := countPDFPages([]byte(tt.pdf)); got != tt.want {
+			t.Errorf("%s: expected %d pages, got %d", tt.name, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package unstructured
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go/test"
+)
+
+func fakeclient(t *testing.T) (*Client, *test.FakeServer) {
+	fake := test.NewFakeServer()
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	c, err := New(
+		WithClient(server.Client()),
+		WithEndpoint(server.URL+"/api/v1"),
+		WithKey(test.FakeAPIKey),
+		WithPollInterval(time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	return c, fake
+}
+
+func TestFakeServer(t *testing.T) {
+	t.Parallel()
+
+	client, fake := fakeclient(t)
+	ctx := testContext(t)
+
+	source, err := client.CreateSource(ctx, CreateSourceRequest{
+		Name:   "docs",
+		Config: &S3ConnectorConfig{RemoteURL: "s3://docs/input/"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+
+	destination, err := client.CreateDestination(ctx, CreateDestinationRequest{
+		Name:   "output",
+		Config: &S3ConnectorConfig{RemoteURL: "s3://docs/output/"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create destination: %v", err)
+	}
+
+	workflow, err := client.CreateWorkflow(ctx, &CreateWorkflowRequest{
+		Name:          "ingest",
+		SourceID:      &source.ID,
+		DestinationID: &destination.ID,
+		WorkflowNodes: []WorkflowNode{
+			&PartitionerFast{Name: "Partitioner"},
+			&ChunkerTitle{Name: "Chunker", MaxCharacters: 500},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create workflow: %v", err)
+	}
+
+	workflows, err := client.ListWorkflows(ctx, &ListWorkflowsRequest{SourceID: &source.ID})
+	if err != nil {
+		t.Fatalf("failed to list workflows: %v", err)
+	}
+
+	if err := errors.Join(
+		eq("workflow.status", workflow.Status, WorkflowStateActive),
+		eq("len(workflow.workflow_nodes)", len(workflow.WorkflowNodes), 2),
+		eq("len(workflows)", len(workflows), 1),
+	); err != nil {
+		t.Error(err)
+	}
+
+	fake.FailFile("broken.txt", "unsupported file")
+
+	job, err := client.RunWorkflow(ctx, &RunWorkflowRequest{
+		ID: workflow.ID,
+		InputFiles: []File{
+			&FileBytes{Filename: "hello.txt", Bytes: strings.NewReader("Hello.\n\nWorld.")},
+			&FileBytes{Filename: "broken.txt", Bytes: strings.NewReader("???")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to run workflow: %v", err)
+	}
+
+	if job.Status != JobStatusScheduled {
+		t.Errorf("expected new job to be %s, got %s", JobStatusScheduled, job.Status)
+	}
+
+	fake.Advance(10 * time.Second)
+
+	if job, err = client.GetJob(ctx, job.ID); err != nil {
+		t.Fatalf("failed to get job: %v", err)
+	} else if job.Status != JobStatusInProgress {
+		t.Errorf("expected running job to be %s, got %s", JobStatusInProgress, job.Status)
+	}
+
+	fake.Advance(time.Minute)
+
+	if job, err = client.GetJob(ctx, job.ID); err != nil {
+		t.Fatalf("failed to get job: %v", err)
+	}
+
+	details, err := client.GetJobDetails(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("failed to get job details: %v", err)
+	}
+
+	failed, err := client.GetJobFailedFiles(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("failed to get job failed files: %v", err)
+	}
+
+	if err := errors.Join(
+		eq("job.status", job.Status, JobStatusCompleted),
+		eq("details.processing_status", details.ProcessingStatus, JobProcessingStatusCompletedWithErrors),
+		eq("details.node_stats[0].failure", details.NodeStats[0].Failure, 1),
+		eq("len(failed_files)", len(failed.FailedFiles), 1),
+		eq("len(job.output_node_files)", len(job.OutputNodeFiles), 1),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	rc, err := client.DownloadJob(ctx, DownloadJobRequest{
+		JobID:  job.ID,
+		NodeID: job.OutputNodeFiles[0].NodeID,
+		FileID: job.OutputNodeFiles[0].FileID,
+	})
+	if err != nil {
+		t.Fatalf("failed to download job output: %v", err)
+	}
+	defer rc.Close()
+
+	var elements []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	if err := json.NewDecoder(rc).Decode(&elements); err != nil {
+		t.Fatalf("failed to decode elements: %v", err)
+	}
+
+	if len(elements) != 2 || elements[1].Text != "World." {
+		t.Errorf("unexpected elements: %+v", elements)
+	}
+
+	fake.FailConnectionCheck(destination.ID, "access denied")
+
+	// keep the fake clock moving while the client polls.
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(time.Millisecond):
+				fake.Advance(time.Second)
+			}
+		}
+	}()
+
+	_, err = client.CheckDestinationConnection(ctx, destination.ID)
+
+	var checkErr *ConnectionCheckError
+	if !errors.As(err, &checkErr) || checkErr.Reason != "access denied" {
+		t.Errorf("expected connection check to fail with reason, got %v", err)
+	}
+}
+
+func TestFakeServerErrors(t *testing.T) {
+	t.Parallel()
+
+	client, _ := fakeclient(t)
+	ctx := testContext(t)
+
+	_, err := client.GetWorkflow(ctx, "00000000-0000-4000-8000-000000000000")
+
+	var apierr *APIError
+	if !errors.As(err, &apierr) || apierr.Code != http.StatusNotFound {
+		t.Errorf("expected a 404 API error, got %v", err)
+	}
+
+	_, err = client.CreateWorkflow(ctx, &CreateWorkflowRequest{
+		SourceID: String("00000000-0000-4000-8000-000000000000"),
+	})
+
+	var verr *HTTPValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected error to be an %T, got %T: %v", verr, err, err)
+	}
+
+	if len(verr.Detail) != 2 {
+		t.Errorf("expected 2 validation errors, got %d: %v", len(verr.Detail), verr)
+	}
+
+	// connectors cannot be renamed, and the fake rejects the name like any unknown field.
+	source, err := client.CreateSource(ctx, CreateSourceRequest{
+		Name:   "docs",
+		Config: &S3ConnectorConfig{RemoteURL: "s3://docs/input/"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx,
+		http.MethodPut,
+		client.endpoint.JoinPath("sources", source.ID).String(),
+		strings.NewReader(`{"name": "renamed", "config": {"remote_url": "s3://docs/input/"}}`),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	err = client.do(req, nil)
+	if !errors.As(err, &verr) || len(verr.Detail) != 1 || verr.Detail[0].Type != "extra_forbidden" {
+		t.Errorf("expected an extra_forbidden validation error, got %v", err)
+	}
+}
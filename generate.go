@@ -0,0 +1,3 @@
+package unstructured
+
+//go:generate go run ./internal/gen/connectors -spec openapi.json -out connectors.gen.go
@@ -0,0 +1,253 @@
+package unstructured
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// ConnectorKind distinguishes source connectors from destination connectors.
+type ConnectorKind string
+
+const (
+	// ConnectorKindSource identifies a source connector.
+	ConnectorKindSource ConnectorKind = "source"
+	// ConnectorKindDestination identifies a destination connector.
+	ConnectorKindDestination ConnectorKind = "destination"
+)
+
+// HealthReportRequest represents the request to build a health report of all connectors.
+type HealthReportRequest struct {
+	// Concurrency is the maximum number of connection checks to run at once.
+	// If zero, 4 checks are run at once.
+	Concurrency int
+}
+
+// HealthReport is the result of running connection checks against every connector in the account.
+type HealthReport struct {
+	Connectors []ConnectorHealth
+}
+
+// ConnectorHealth is the outcome of a connection check for a single connector,
+// along with the workflows that depend on it.
+type ConnectorHealth struct {
+	ID      string
+	Name    string
+	Kind    ConnectorKind
+	Type    string
+	Status  ConnectionCheckStatus
+	Reason  string
+	Latency time.Duration
+
+	// Workflows lists the workflows that reference this connector.
+	// It is only populated for connectors that are not healthy.
+	Workflows []Workflow
+
+	// Err holds the error returned while checking the connector, if any.
+	Err error
+}
+
+// Healthy reports whether the connection check for the connector succeeded.
+func (h ConnectorHealth) Healthy() bool { return h.Status == ConnectionCheckStatusSuccess }
+
+// HealthReport lists all source and destination connectors and runs connection checks on them concurrently.
+// It waits for every check to finish and, for each connector that is not healthy,
+// looks up the workflows that reference it.
+// A connector that fails its check does not cause HealthReport to return an error;
+// the failure is recorded in the report instead.
+// If ctx is cancelled while checks are running, HealthReport returns the partial report along with the error,
+// and the connectors that were not checked carry the context error.
+func (c *Client) HealthReport(ctx context.Context, in *HealthReportRequest) (*HealthReport, error) {
+	sources, err := c.ListSources(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	destinations, err := c.ListDestinations(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	report := HealthReport{
+		Connectors: make([]ConnectorHealth, 0, len(sources)+len(destinations)),
+	}
+
+	for _, src := range sources {
+		report.Connectors = append(report.Connectors, ConnectorHealth{
+			ID:   src.ID,
+			Name: src.Name,
+			Kind: ConnectorKindSource,
+			Type: src.Config.Type(),
+		})
+	}
+
+	for _, dst := range destinations {
+		report.Connectors = append(report.Connectors, ConnectorHealth{
+			ID:   dst.ID,
+			Name: dst.Name,
+			Kind: ConnectorKindDestination,
+			Type: dst.Type,
+		})
+	}
+
+	concurrency := 4
+	if in != nil && in.Concurrency > 0 {
+		concurrency = in.Concurrency
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+
+	for i := range report.Connectors {
+		wg.Add(1)
+
+		go func(h *ConnectorHealth) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				h.Err = ctx.Err()
+				h.Reason = h.Err.Error()
+
+				return
+			}
+
+			c.checkConnectorHealth(ctx, h)
+		}(&report.Connectors[i])
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return &report, fmt.Errorf("failed to build health report: %w", err)
+	}
+
+	return &report, nil
+}
+
+// checkConnectorHealth runs a connection check for a single connector and records the outcome in h.
+func (c *Client) checkConnectorHealth(ctx context.Context, h *ConnectorHealth) {
+	check := c.CheckSourceConnection
+	filter := ListWorkflowsRequest{SourceID: &h.ID}
+
+	if h.Kind == ConnectorKindDestination {
+		check = c.CheckDestinationConnection
+		filter = ListWorkflowsRequest{DestinationID: &h.ID}
+	}
+
+	start := time.Now()
+	result, err := check(ctx, h.ID)
+	h.Latency = time.Since(start)
+
+	if result != nil {
+		h.Status = result.Status
+		h.Reason = ToString(result.Reason)
+
+		if !result.CreatedAt.IsZero() && !result.ReportedAt.IsZero() {
+			h.Latency = result.ReportedAt.Sub(result.CreatedAt)
+		}
+	}
+
+	if err != nil {
+		h.Err = err
+
+		var checkErr *ConnectionCheckError
+		if !errors.As(err, &checkErr) {
+			h.Status = ConnectionCheckStatusFailure
+			h.Reason = err.Error()
+		}
+	}
+
+	if h.Healthy() {
+		return
+	}
+
+	workflows, err := c.listAllWorkflows(ctx, filter)
+	if err != nil {
+		h.Err = errors.Join(h.Err, err)
+		return
+	}
+
+	h.Workflows = workflows
+}
+
+// Failing returns the connectors whose connection check did not succeed.
+func (r *HealthReport) Failing() []ConnectorHealth {
+	var failing []ConnectorHealth
+
+	for _, h := range r.Connectors {
+		if !h.Healthy() {
+			failing = append(failing, h)
+		}
+	}
+
+	return failing
+}
+
+// WriteTo writes the report as a table to w, with failing connectors listed first.
+// It implements the [io.WriterTo] interface.
+func (r *HealthReport) WriteTo(w io.Writer) (int64, error) {
+	rows := slices.Clone(r.Connectors)
+	slices.SortStableFunc(rows, func(a, b ConnectorHealth) int {
+		if a.Healthy() != b.Healthy() {
+			if a.Healthy() {
+				return 1
+			}
+
+			return -1
+		}
+
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	cw := &countingWriter{w: w}
+	tw := tabwriter.NewWriter(cw, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "NAME\tKIND\tTYPE\tSTATUS\tLATENCY\tREASON\tBROKEN WORKFLOWS")
+
+	for _, h := range rows {
+		names := make([]string, len(h.Workflows))
+		for i, wf := range h.Workflows {
+			names[i] = wf.Name
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			h.Name,
+			h.Kind,
+			h.Type,
+			h.Status,
+			h.Latency.Round(time.Millisecond),
+			strings.ReplaceAll(h.Reason, "\n", " "),
+			strings.Join(names, ", "),
+		)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return cw.n, fmt.Errorf("failed to write health report: %w", err)
+	}
+
+	return cw.n, nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+
+	return n, err //nolint:wrapcheck
+}
@@ -0,0 +1,191 @@
+package unstructured
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHealthReport(t *testing.T) {
+	t.Parallel()
+
+	client, mux := testclient(t, WithPollInterval(time.Millisecond))
+
+	const (
+		goodSource = "f1f7b1b2-8e4b-4a2b-8f1d-3e3c7c9e5a3c"
+		badDest    = "aeebecc7-9d8e-4625-bf1d-815c2f084869"
+	)
+
+	mux.ListSources = func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{` +
+			`  "id": "` + goodSource + `",` +
+			`  "name": "docs-bucket",` +
+			`  "type": "s3",` +
+			`  "config": {"remote_url": "s3://docs/"}` +
+			`}]`))
+	}
+
+	mux.ListDestinations = func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{` +
+			`  "id": "` + badDest + `",` +
+			`  "name": "vectors",` +
+			`  "type": "pinecone",` +
+			`  "config": {"index_name": "docs", "api_key": "foo", "namespace": "default"}` +
+			`}]`))
+	}
+
+	check := func(status, reason string) func(w http.ResponseWriter, r *http.Request) {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{` +
+				`  "id": "c9f8e7d6-5c4b-3a2f-1e0d-9c8b7a6f5e4d",` +
+				`  "status": "` + status + `",` +
+				`  "reason": ` + reason + `,` +
+				`  "created_at": "2025-06-22T11:37:21.000Z",` +
+				`  "reported_at": "2025-06-22T11:37:21.250Z"` +
+				`}`))
+		}
+	}
+
+	mux.CreateConnectionCheckSources = check("SUCCESS", `null`)
+	mux.CreateConnectionCheckDestinations = check("FAILURE", `"401 unauthorized"`)
+
+	// more broken workflows than fit on the API's default page of 20.
+	const broken = 21
+
+	mux.ListWorkflows = func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if val := q.Get("destination_id"); val != badDest {
+			http.Error(w, "unexpected destination_id "+val, http.StatusBadRequest)
+			return
+		}
+
+		page, _ := strconv.Atoi(q.Get("page"))
+		size, _ := strconv.Atoi(q.Get("page_size"))
+
+		workflows := make([]string, 0, size)
+		for i := (page - 1) * size; i < min(page*size, broken); i++ {
+			workflows = append(workflows, `{`+
+				`  "id": "16b80fee-64dc-472d-8f26-1d7729b642`+fmt.Sprintf("%02d", i)+`",`+
+				`  "name": "nightly-ingest-`+strconv.Itoa(i)+`",`+
+				`  "sources": ["`+goodSource+`"],`+
+				`  "destinations": ["`+badDest+`"],`+
+				`  "workflow_nodes": [],`+
+				`  "status": "active"`+
+				`}`)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[` + strings.Join(workflows, ",") + `]`))
+	}
+
+	report, err := client.HealthReport(testContext(t), &HealthReportRequest{Concurrency: 1})
+	if err != nil {
+		t.Fatalf("failed to build health report: %v", err)
+	}
+
+	if len(report.Connectors) != 2 {
+		t.Fatalf("expected 2 connectors, got %d", len(report.Connectors))
+	}
+
+	failing := report.Failing()
+	if len(failing) != 1 {
+		t.Fatalf("expected 1 failing connector, got %d", len(failing))
+	}
+
+	bad := failing[0]
+	if err := errors.Join(
+		eq("failing.id", bad.ID, badDest),
+		eq("failing.kind", bad.Kind, ConnectorKindDestination),
+		eq("failing.type", bad.Type, ConnectorTypePinecone),
+		eq("failing.reason", bad.Reason, "401 unauthorized"),
+		eq("failing.latency", bad.Latency, 250*time.Millisecond),
+		eq("len(failing.workflows)", len(bad.Workflows), broken),
+		eq("report.connectors[0].type", report.Connectors[0].Type, ConnectorTypeS3),
+		eq("report.connectors[0].status", report.Connectors[0].Status, ConnectionCheckStatusSuccess),
+	); err != nil {
+		t.Error(err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := report.WriteTo(&buf); err != nil {
+		t.Fatalf("failed to write health report: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines in table, got %d:\n%s", len(lines), buf.String())
+	}
+
+	if !strings.HasPrefix(lines[1], "vectors") || !strings.Contains(lines[1], "nightly-ingest") {
+		t.Errorf("expected failing connector first with its broken workflow, got %q", lines[1])
+	}
+}
+
+func TestHealthReportCancelled(t *testing.T) {
+	t.Parallel()
+
+	client, mux := testclient(t, WithPollInterval(time.Millisecond))
+	ctx, cancel := context.WithCancel(testContext(t))
+	defer cancel()
+
+	mux.ListSources = func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "f1f7b1b2-8e4b-4a2b-8f1d-3e3c7c9e5a3c", "name": "docs-bucket", "type": "s3", "config": {"remote_url": "s3://docs/"}}]`))
+	}
+
+	mux.ListDestinations = func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "aeebecc7-9d8e-4625-bf1d-815c2f084869", "name": "vectors", "type": "s3", "config": {"remote_url": "s3://vectors/"}}]`))
+	}
+
+	// connectors are checked one at a time, and the context is cancelled during the second check.
+	var checks atomic.Int32
+
+	check := func(w http.ResponseWriter, r *http.Request) {
+		if checks.Add(1) > 1 {
+			cancel()
+			<-r.Context().Done()
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{` +
+			`  "id": "c9f8e7d6-5c4b-3a2f-1e0d-9c8b7a6f5e4d",` +
+			`  "status": "SUCCESS",` +
+			`  "created_at": "2025-06-22T11:37:21.000Z",` +
+			`  "reported_at": "2025-06-22T11:37:21.250Z"` +
+			`}`))
+	}
+
+	mux.CreateConnectionCheckSources = check
+	mux.CreateConnectionCheckDestinations = check
+
+	report, err := client.HealthReport(ctx, &HealthReportRequest{Concurrency: 1})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error to be %v, got %v", context.Canceled, err)
+	}
+
+	if report == nil || len(report.Connectors) != 2 {
+		t.Fatalf("expected a partial report of 2 connectors, got %+v", report)
+	}
+
+	failing := report.Failing()
+	if len(failing) != 1 {
+		t.Fatalf("expected 1 unchecked connector, got %d", len(failing))
+	}
+
+	if !errors.Is(failing[0].Err, context.Canceled) {
+		t.Errorf("expected unchecked connector error to be %v, got %v", context.Canceled, failing[0].Err)
+	}
+}
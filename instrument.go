@@ -0,0 +1,294 @@
+package unstructured
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Tracer starts spans, which record the operations of a client.
+// It mirrors the tracer of OpenTelemetry, so that an adapter to any tracing library is a few lines long,
+// without the SDK depending on one.
+type Tracer interface {
+	// Start starts a span with the given name as a child of any span in ctx,
+	// and returns a context that carries the new span.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is an operation that is being traced, started by a [Tracer].
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	AddEvent(name string, attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// Meter records the metrics of a client.
+// Like [Tracer], it mirrors the instruments of OpenTelemetry without depending on it.
+type Meter interface {
+	// Record records a value of the histogram with the given name.
+	Record(ctx context.Context, name string, value float64, attrs ...Attribute)
+
+	// Add adds incr to the counter with the given name.
+	Add(ctx context.Context, name string, incr int64, attrs ...Attribute)
+}
+
+// Attribute is a key-value pair that describes a span, an event or a metric.
+// Values are strings, ints or bools.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// Names of the metrics a client records with its [Meter].
+const (
+	// MetricOperationDuration is a histogram of how long API calls take, in seconds.
+	MetricOperationDuration = "unstructured.client.operation.duration"
+
+	// MetricOperationErrors counts the API calls that fail, with a network error or an error status.
+	MetricOperationErrors = "unstructured.client.operation.errors"
+)
+
+// WithTracer returns an Option that traces the client with t.
+// Every API call gets a span named after the client method that makes it, like "RunWorkflow",
+// with the IDs of the resources involved and the HTTP status as attributes.
+// Waiting for a job to finish gets a "WaitJob" span of its own, with an event for each status change.
+func WithTracer(t Tracer) Option {
+	return func(c *Client) error {
+		c.tracer = t
+		return nil
+	}
+}
+
+// WithMeter returns an Option that records the latency and errors of the client's API calls with m,
+// as [MetricOperationDuration] and [MetricOperationErrors] by operation.
+func WithMeter(m Meter) Option {
+	return func(c *Client) error {
+		c.meter = m
+		return nil
+	}
+}
+
+// startSpan starts a span with the client's tracer, or a span that records nothing if it has none.
+func (c *Client) startSpan(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	if c.tracer == nil {
+		return ctx, noopSpan{}
+	}
+
+	return c.tracer.Start(ctx, name, attrs...)
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(...Attribute)    {}
+func (noopSpan) AddEvent(string, ...Attribute) {}
+func (noopSpan) RecordError(error)             {}
+func (noopSpan) End()                          {}
+
+// instrument is a [http.RoundTripper] that traces and measures each API call.
+// It wraps the retries of the [policy], so that a call is a single span however often it is sent.
+type instrument struct {
+	rt     http.RoundTripper
+	base   string
+	tracer Tracer
+	meter  Meter
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (in *instrument) RoundTrip(req *http.Request) (*http.Response, error) {
+	op, attrs := in.operation(req)
+
+	ctx := req.Context()
+
+	span := Span(noopSpan{})
+	if in.tracer != nil {
+		ctx, span = in.tracer.Start(ctx, op, attrs...)
+		req = req.WithContext(ctx)
+	}
+
+	defer span.End()
+
+	start := time.Now()
+	resp, err := in.rt.RoundTrip(req)
+	elapsed := time.Since(start)
+
+	metric := []Attribute{{"unstructured.operation", op}}
+
+	var errorType string
+
+	switch {
+	case err != nil:
+		errorType = "transport"
+
+		span.RecordError(err)
+	case resp.StatusCode >= http.StatusBadRequest:
+		errorType = strconv.Itoa(resp.StatusCode)
+
+		span.SetAttributes(Attribute{"http.response.status_code", resp.StatusCode})
+		span.RecordError(&APIError{Code: resp.StatusCode, Err: errors.New(http.StatusText(resp.StatusCode))})
+	default:
+		span.SetAttributes(Attribute{"http.response.status_code", resp.StatusCode})
+		span.SetAttributes(createdID(op, resp)...)
+	}
+
+	if errorType != "" {
+		span.SetAttributes(Attribute{"error.type", errorType})
+	}
+
+	if in.meter != nil {
+		if resp != nil {
+			metric = append(metric, Attribute{"http.response.status_code", resp.StatusCode})
+		}
+
+		in.meter.Record(ctx, MetricOperationDuration, elapsed.Seconds(), metric...)
+
+		if errorType != "" {
+			in.meter.Add(ctx, MetricOperationErrors, 1, append(metric, Attribute{"error.type", errorType})...)
+		}
+	}
+
+	return resp, err //nolint:wrapcheck
+}
+
+// route maps a request to an API path to the client method that makes it.
+// Path segments starting with '{' are resource IDs, which are recorded as the attribute they name.
+type route struct {
+	method string
+	path   string
+	op     string
+}
+
+var routes = []route{
+	{http.MethodGet, "sources", "ListSources"},
+	{http.MethodPost, "sources", "CreateSource"},
+	{http.MethodGet, "sources/{unstructured.source.id}", "GetSource"},
+	{http.MethodPut, "sources/{unstructured.source.id}", "UpdateSource"},
+	{http.MethodDelete, "sources/{unstructured.source.id}", "DeleteSource"},
+	{http.MethodPost, "sources/{unstructured.source.id}/connection-check", "CreateSourceConnectionCheck"},
+	{http.MethodGet, "sources/{unstructured.source.id}/connection-check", "GetSourceConnectionCheck"},
+	{http.MethodGet, "destinations", "ListDestinations"},
+	{http.MethodPost, "destinations", "CreateDestination"},
+	{http.MethodGet, "destinations/{unstructured.destination.id}", "GetDestination"},
+	{http.MethodPut, "destinations/{unstructured.destination.id}", "UpdateDestination"},
+	{http.MethodDelete, "destinations/{unstructured.destination.id}", "DeleteDestination"},
+	{http.MethodPost, "destinations/{unstructured.destination.id}/connection-check", "CreateDestinationConnectionCheck"},
+	{http.MethodGet, "destinations/{unstructured.destination.id}/connection-check", "GetDestinationConnectionCheck"},
+	{http.MethodGet, "workflows", "ListWorkflows"},
+	{http.MethodPost, "workflows", "CreateWorkflow"},
+	{http.MethodGet, "workflows/{unstructured.workflow.id}", "GetWorkflow"},
+	{http.MethodPut, "workflows/{unstructured.workflow.id}", "UpdateWorkflow"},
+	{http.MethodDelete, "workflows/{unstructured.workflow.id}", "DeleteWorkflow"},
+	{http.MethodPost, "workflows/{unstructured.workflow.id}/run", "RunWorkflow"},
+	{http.MethodGet, "jobs", "ListJobs"},
+	{http.MethodGet, "jobs/{unstructured.job.id}", "GetJob"},
+	{http.MethodPost, "jobs/{unstructured.job.id}/cancel", "CancelJob"},
+	{http.MethodGet, "jobs/{unstructured.job.id}/details", "GetJobDetails"},
+	{http.MethodGet, "jobs/{unstructured.job.id}/failed-files", "GetJobFailedFiles"},
+	{http.MethodGet, "jobs/{unstructured.job.id}/download", "DownloadJob"},
+}
+
+// operation returns the name of the client method that made req and the attributes of its span.
+// Requests to unknown paths are named after their method and path.
+func (in *instrument) operation(req *http.Request) (string, []Attribute) {
+	rel := strings.Trim(strings.TrimPrefix(req.URL.Path, in.base), "/")
+	segments := strings.Split(rel, "/")
+
+	attrs := []Attribute{
+		{"http.request.method", req.Method},
+		{"url.path", req.URL.Path},
+	}
+
+	for _, r := range routes {
+		if r.method != req.Method {
+			continue
+		}
+
+		ids, ok := matchRoute(r.path, segments)
+		if !ok {
+			continue
+		}
+
+		if r.op == "DownloadJob" {
+			q := req.URL.Query()
+			ids = append(ids,
+				Attribute{"unstructured.node.id", q.Get("node_id")},
+				Attribute{"unstructured.file.id", q.Get("file_id")},
+			)
+		}
+
+		return r.op, append(attrs, ids...)
+	}
+
+	return req.Method + " " + req.URL.Path, attrs
+}
+
+func matchRoute(path string, segments []string) ([]Attribute, bool) {
+	pattern := strings.Split(path, "/")
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+
+	var ids []Attribute
+
+	for i, p := range pattern {
+		if strings.HasPrefix(p, "{") {
+			ids = append(ids, Attribute{strings.Trim(p, "{}"), segments[i]})
+		} else if p != segments[i] {
+			return nil, false
+		}
+	}
+
+	return ids, true
+}
+
+// createdID returns the ID of the resource created by a successful call as an attribute,
+// reading it from the response without consuming the body.
+func createdID(op string, resp *http.Response) []Attribute {
+	var key string
+
+	switch op {
+	case "CreateSource":
+		key = "unstructured.source.id"
+	case "CreateDestination":
+		key = "unstructured.destination.id"
+	case "CreateWorkflow":
+		key = "unstructured.workflow.id"
+	case "RunWorkflow":
+		key = "unstructured.job.id"
+	default:
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	// the caller still reads the body, including any error reading it.
+	resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), errReader{err}))
+
+	var created struct {
+		ID string `json:"id"`
+	}
+
+	if err != nil || json.Unmarshal(body, &created) != nil || created.ID == "" {
+		return nil
+	}
+
+	return []Attribute{{key, created.ID}}
+}
+
+// errReader returns err from every read, or io.EOF if err is nil.
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	return 0, io.EOF
+}
@@ -0,0 +1,197 @@
+package unstructured
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go/test"
+)
+
+type spanKey struct{}
+
+type recordedSpan struct {
+	name   string
+	parent string
+	attrs  map[string]any
+	events []string
+	errs   []error
+	ended  bool
+}
+
+func (s *recordedSpan) SetAttributes(attrs ...Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *recordedSpan) AddEvent(name string, attrs ...Attribute) {
+	for _, a := range attrs {
+		name += " " + a.Key + "=" + a.Value.(string)
+	}
+
+	s.events = append(s.events, name)
+}
+
+func (s *recordedSpan) RecordError(err error) { s.errs = append(s.errs, err) }
+func (s *recordedSpan) End()                  { s.ended = true }
+
+type recordingTracer struct {
+	mu    sync.Mutex
+	spans []*recordedSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := &recordedSpan{name: name, attrs: make(map[string]any)}
+	s.SetAttributes(attrs...)
+
+	if parent, ok := ctx.Value(spanKey{}).(*recordedSpan); ok {
+		s.parent = parent.name
+	}
+
+	t.spans = append(t.spans, s)
+
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+func (t *recordingTracer) find(name string) []*recordedSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var found []*recordedSpan
+
+	for _, s := range t.spans {
+		if s.name == name {
+			found = append(found, s)
+		}
+	}
+
+	return found
+}
+
+type recordingMeter struct {
+	mu        sync.Mutex
+	durations map[string]int
+	errors    map[string]int64
+}
+
+func (m *recordingMeter) Record(_ context.Context, name string, _ float64, attrs ...Attribute) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.durations[name+" "+attrs[0].Value.(string)]++
+}
+
+func (m *recordingMeter) Add(_ context.Context, name string, incr int64, attrs ...Attribute) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.errors[name+" "+attrs[0].Value.(string)] += incr
+}
+
+func TestInstrumentation(t *testing.T) {
+	t.Parallel()
+
+	fake := test.NewFakeServer()
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	tracer := &recordingTracer{}
+	meter := &recordingMeter{durations: make(map[string]int), errors: make(map[string]int64)}
+
+	client, err := New(
+		WithClient(server.Client()),
+		WithEndpoint(server.URL+"/api/v1"),
+		WithKey(test.FakeAPIKey),
+		WithPollInterval(time.Millisecond),
+		WithTracer(tracer),
+		WithMeter(meter),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	ctx := testContext(t)
+
+	workflow, err := client.CreateWorkflow(ctx, &CreateWorkflowRequest{
+		Name:          "ingest",
+		WorkflowNodes: []WorkflowNode{&PartitionerFast{Name: "Partitioner"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create workflow: %v", err)
+	}
+
+	job, err := client.RunWorkflow(ctx, &RunWorkflowRequest{
+		ID:         workflow.ID,
+		InputFiles: []File{&FileBytes{Filename: "hello.txt", Bytes: strings.NewReader("Hello.")}},
+	})
+	if err != nil {
+		t.Fatalf("failed to run workflow: %v", err)
+	}
+
+	if _, err := client.GetJob(ctx, "missing"); err == nil {
+		t.Fatal("expected an error getting a missing job")
+	}
+
+	err = client.WatchJob(ctx, job.ID, func(*JobProgress) error {
+		fake.Advance(20 * time.Second)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to watch job: %v", err)
+	}
+
+	create := tracer.find("CreateWorkflow")
+	run := tracer.find("RunWorkflow")
+	wait := tracer.find("WaitJob")
+	details := tracer.find("GetJobDetails")
+
+	if len(create) != 1 || len(run) != 1 || len(wait) != 1 || len(details) == 0 {
+		t.Fatalf("expected one CreateWorkflow, RunWorkflow and WaitJob span and GetJobDetails spans, got %d, %d, %d and %d",
+			len(create), len(run), len(wait), len(details))
+	}
+
+	var missing *recordedSpan
+
+	for _, s := range tracer.find("GetJob") {
+		if s.attrs["unstructured.job.id"] == "missing" {
+			missing = s
+		}
+	}
+
+	if missing == nil {
+		t.Fatal("expected a GetJob span for the missing job")
+	}
+
+	if err := errors.Join(
+		eq("create workflow id", create[0].attrs["unstructured.workflow.id"], any(workflow.ID)),
+		eq("create status", create[0].attrs["http.response.status_code"], any(200)),
+		eq("create ended", create[0].ended, true),
+		eq("run workflow id", run[0].attrs["unstructured.workflow.id"], any(workflow.ID)),
+		eq("run job id", run[0].attrs["unstructured.job.id"], any(job.ID)),
+		eq("missing status", missing.attrs["http.response.status_code"], any(404)),
+		eq("missing error type", missing.attrs["error.type"], any("404")),
+		eq("len(missing errors)", len(missing.errs), 1),
+		eq("details parent", details[0].parent, "WaitJob"),
+		eq("wait job id", wait[0].attrs["unstructured.job.id"], any(job.ID)),
+		eq("wait ended", wait[0].ended, true),
+		eqs("wait events", wait[0].events, []string{
+			"status change unstructured.job.status=SCHEDULED",
+			"status change unstructured.job.status=IN_PROGRESS",
+			"status change unstructured.job.status=COMPLETED",
+		}),
+		eq("create durations", meter.durations[MetricOperationDuration+" CreateWorkflow"], 1),
+		eq("get job errors", meter.errors[MetricOperationErrors+" GetJob"], 1),
+		eq("run workflow errors", meter.errors[MetricOperationErrors+" RunWorkflow"], 0),
+	); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,398 @@
+// Command connectors generates the connector config types from the schemas in openapi.json.
+//
+// It emits the *ConnectorConfig structs, their Type methods, the ConnectorType* constants
+// and the sourceConfigFactories and destinationConfigFactories maps.
+// Which schemas make up each Go type, and how they are documented, is described by the
+// manifest in manifest.go. Connectors that are missing from the spec are written by hand
+// and only get constants and factory entries.
+//
+// Usage:
+//
+//	go run ./internal/gen/connectors -spec openapi.json -out connectors.gen.go
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"strings"
+)
+
+func main() {
+	spec := flag.String("spec", "openapi.json", "path to the OpenAPI spec")
+	out := flag.String("out", "connectors.gen.go", "path to the generated file")
+	flag.Parse()
+
+	f, err := os.Open(*spec)
+	if err != nil {
+		log.Fatalf("failed to open spec: %v", err)
+	}
+	defer f.Close()
+
+	src, err := generate(f, manifest)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil { //nolint:gosec
+		log.Fatalf("failed to write %s: %v", *out, err)
+	}
+}
+
+type spec struct {
+	Components struct {
+		Schemas map[string]*schema `json:"schemas"`
+	} `json:"components"`
+}
+
+type schema struct {
+	Ref        string     `json:"$ref"`
+	Type       string     `json:"type"`
+	Enum       []string   `json:"enum"`
+	Items      *schema    `json:"items"`
+	AnyOf      []*schema  `json:"anyOf"`
+	Properties properties `json:"properties"`
+	Required   []string   `json:"required"`
+}
+
+type property struct {
+	Name   string
+	Schema *schema
+}
+
+// properties keeps the order in which properties appear in the spec,
+// so that generated fields follow it.
+type properties []property
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *properties) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("failed to read properties: %w", err)
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to read property name: %w", err)
+		}
+
+		name, _ := tok.(string)
+
+		var s schema
+		if err := dec.Decode(&s); err != nil {
+			return fmt.Errorf("failed to read property %s: %w", name, err)
+		}
+
+		*p = append(*p, property{Name: name, Schema: &s})
+	}
+
+	return nil
+}
+
+type field struct {
+	Name     string
+	Type     string
+	JSON     string
+	Optional bool
+}
+
+func generate(r io.Reader, connectors []connector) ([]byte, error) {
+	var s spec
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed to parse spec: %w", err)
+	}
+
+	schemas := s.Components.Schemas
+
+	consts := make(map[string]string)
+	for _, c := range connectors {
+		consts[c.Type] = c.Const
+	}
+
+	var (
+		values []string
+		errs   []error
+	)
+
+	for _, name := range []string{"SourceConnectorType", "DestinationConnectorType"} {
+		enum, ok := schemas[name]
+		if !ok {
+			return nil, fmt.Errorf("schema %s does not exist", name)
+		}
+
+		for _, v := range enum.Enum {
+			if _, ok := consts[v]; !ok {
+				errs = append(errs, fmt.Errorf("%s %q is not in the manifest", name, v))
+			}
+
+			if !slices.Contains(values, v) {
+				values = append(values, v)
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(values, func(a, b string) int { return strings.Compare(consts[a], consts[b]) })
+
+	var buf bytes.Buffer
+
+	fmt.Fprintln(&buf, "// Code generated by internal/gen/connectors from openapi.json; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package unstructured")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// Connector type constants")
+	fmt.Fprintln(&buf, "const (")
+
+	for _, v := range values {
+		fmt.Fprintf(&buf, "\t%s = %q\n", consts[v], v)
+	}
+
+	fmt.Fprintln(&buf, ")")
+
+	writeFactories(&buf, connectors, "source", "SourceConfig", func(c connector) bool { return c.Source })
+	writeFactories(&buf, connectors, "destination", "DestinationConfig", func(c connector) bool { return c.Destination })
+
+	for _, c := range connectors {
+		if len(c.Schemas) == 0 {
+			continue
+		}
+
+		fields, err := mergeFields(schemas, c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate %s: %w", c.Name, err)
+		}
+
+		writeConnector(&buf, c, fields)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+
+	return src, nil
+}
+
+func writeFactories(w io.Writer, connectors []connector, kind, iface string, include func(connector) bool) {
+	entries := slices.DeleteFunc(slices.Clone(connectors), func(c connector) bool {
+		return !include(c) || c.NoFactory
+	})
+
+	slices.SortFunc(entries, func(a, b connector) int { return strings.Compare(a.Const, b.Const) })
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "// %sConfigFactories maps %s type strings to factory functions\n", kind, kind)
+	fmt.Fprintf(w, "// that create new instances of the appropriate concrete %s config type.\n", kind)
+	fmt.Fprintf(w, "// Using a map here also provides a compile-time check that all %s type strings are unique.\n", kind)
+	fmt.Fprintf(w, "var %sConfigFactories = map[string]func() %s{\n", kind, iface)
+
+	for _, c := range entries {
+		fmt.Fprintf(w, "\t%s: func() %s { return new(%s) },\n", c.Const, iface, c.Name)
+	}
+
+	fmt.Fprintln(w, "}")
+}
+
+func writeConnector(w io.Writer, c connector, fields []field) {
+	fmt.Fprintln(w)
+
+	for i, line := range strings.Split(strings.TrimSpace(c.Doc), "\n") {
+		if i == 0 {
+			line = c.Name + " " + line
+		}
+
+		fmt.Fprintf(w, "// %s\n", line)
+	}
+
+	fmt.Fprintf(w, "type %s struct {\n", c.Name)
+
+	if c.Source {
+		fmt.Fprintln(w, "\tsourceconfig")
+	}
+
+	if c.Destination {
+		fmt.Fprintln(w, "\tdestinationconfig")
+	}
+
+	fmt.Fprintln(w)
+
+	for _, f := range fields {
+		tag := f.JSON
+		if f.Optional {
+			tag += ",omitempty"
+		}
+
+		fmt.Fprintf(w, "\t%s %s `json:%q`\n", f.Name, f.Type, tag)
+	}
+
+	fmt.Fprintln(w, "}")
+
+	if !c.NoFactory {
+		fmt.Fprintln(w)
+
+		if c.Source {
+			fmt.Fprintf(w, "var _ SourceConfig = (*%s)(nil)\n", c.Name)
+		}
+
+		if c.Destination {
+			fmt.Fprintf(w, "var _ DestinationConfig = (*%s)(nil)\n", c.Name)
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "// Type always returns the connector type identifier for %s: %q.\n", c.Label, c.Type)
+	fmt.Fprintf(w, "func (c %s) Type() string { return %s }\n", c.Name, c.Const)
+}
+
+// mergeFields merges the properties of the connector's schemas, in order.
+// A property is only required if every schema requires it.
+func mergeFields(schemas map[string]*schema, c connector) ([]field, error) {
+	var fields []field
+
+	required := make(map[string]int)
+
+	for _, name := range c.Schemas {
+		s, ok := schemas[name]
+		if !ok {
+			return nil, fmt.Errorf("schema %s does not exist", name)
+		}
+
+		for _, r := range s.Required {
+			required[r]++
+		}
+
+		for _, p := range s.Properties {
+			if slices.ContainsFunc(fields, func(f field) bool { return f.JSON == p.Name }) {
+				continue
+			}
+
+			typ, err := goType(schemas, p.Schema)
+			if err != nil {
+				return nil, fmt.Errorf("property %s: %w", p.Name, err)
+			}
+
+			fields = append(fields, field{Name: goName(p.Name), Type: typ, JSON: p.Name})
+		}
+	}
+
+	for i, f := range fields {
+		o := c.Fields[f.JSON]
+		f.Optional = o.Optional || required[f.JSON] < len(c.Schemas)
+
+		if f.Optional && !strings.HasPrefix(f.Type, "[]") && !strings.HasPrefix(f.Type, "map[") {
+			f.Type = "*" + f.Type
+		}
+
+		f.Name = cmp.Or(o.Name, f.Name)
+		f.Type = cmp.Or(o.Type, f.Type)
+
+		fields[i] = f
+	}
+
+	for name := range c.Fields {
+		if !slices.ContainsFunc(fields, func(f field) bool { return f.JSON == name }) {
+			return nil, fmt.Errorf("override for unknown property %s", name)
+		}
+	}
+
+	return fields, nil
+}
+
+func goType(schemas map[string]*schema, s *schema) (string, error) {
+	if s.Ref != "" {
+		ref, ok := schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")]
+		if !ok {
+			return "", fmt.Errorf("unresolved reference %s", s.Ref)
+		}
+
+		return goType(schemas, ref)
+	}
+
+	if len(s.AnyOf) > 0 {
+		alts := slices.DeleteFunc(slices.Clone(s.AnyOf), func(alt *schema) bool { return alt.Type == "null" })
+
+		switch {
+		case len(alts) == 1:
+			return goType(schemas, alts[0])
+		case slices.ContainsFunc(alts, func(alt *schema) bool { return alt.Type == "string" }):
+			// values that may also be given as a plain string, like secret references.
+			return "string", nil
+		}
+
+		return "", fmt.Errorf("cannot map union of %d schemas", len(alts))
+	}
+
+	switch s.Type {
+	case "string":
+		return "string", nil
+	case "integer":
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		if s.Items == nil {
+			return "[]any", nil
+		}
+
+		item, err := goType(schemas, s.Items)
+		if err != nil {
+			return "", err
+		}
+
+		return "[]" + item, nil
+	case "object":
+		if len(s.Properties) > 0 {
+			return "", errors.New("nested objects are not supported")
+		}
+
+		return "map[string]any", nil
+	}
+
+	return "", fmt.Errorf("unsupported schema type %q", s.Type)
+}
+
+// initialisms are the words of property names that are written in upper case, or otherwise specially, in Go names.
+var initialisms = map[string]string{
+	"api":   "API",
+	"db":    "DB",
+	"es":    "ES",
+	"http":  "HTTP",
+	"id":    "ID",
+	"pname": "PName",
+	"sas":   "SAS",
+	"ssl":   "SSL",
+	"uri":   "URI",
+	"url":   "URL",
+}
+
+func goName(s string) string {
+	var b strings.Builder
+
+	for _, word := range strings.Split(s, "_") {
+		if v, ok := initialisms[word]; ok {
+			b.WriteString(v)
+			continue
+		}
+
+		if word != "" {
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+
+	return b.String()
+}
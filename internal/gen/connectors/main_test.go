@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGeneratedUpToDate(t *testing.T) {
+	t.Parallel()
+
+	spec, err := os.Open("../../../openapi.json")
+	if err != nil {
+		t.Fatalf("failed to open spec: %v", err)
+	}
+	defer spec.Close()
+
+	got, err := generate(spec, manifest)
+	if err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+
+	want, err := os.ReadFile("../../../connectors.gen.go")
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Error("connectors.gen.go is out of date, run go generate")
+	}
+}
+
+func TestGoName(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]string{
+		"remote_url":    "RemoteURL",
+		"es_api_key":    "ESAPIKey",
+		"user_pname":    "UserPName",
+		"db_name":       "DBName",
+		"record_id_key": "RecordIDKey",
+		"max_retries":   "MaxRetries",
+	} {
+		if got := goName(in); got != want {
+			t.Errorf("goName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,419 @@
+package main
+
+// connector describes one generated connector config type.
+type connector struct {
+	// Name is the Go type name.
+	Name string
+	// Const is the name of the ConnectorType constant and Type is its value.
+	Const string
+	Type  string
+	// Label is the product name used in the Type method's comment.
+	Label string
+	// Doc is the type's doc comment, without the leading type name.
+	Doc string
+
+	Source      bool
+	Destination bool
+
+	// Schemas are the spec schemas whose properties make up the type.
+	// Types without schemas are written by hand and only get constants and factory entries.
+	Schemas []string
+
+	// NoFactory leaves the type out of the config factories.
+	NoFactory bool
+
+	// Fields overrides the generated Go name or type of properties, by JSON name.
+	Fields map[string]override
+}
+
+type override struct {
+	Name string
+	Type string
+	// Optional makes a required property optional.
+	Optional bool
+}
+
+var manifest = []connector{
+	// shared connectors.
+	{
+		Name:        "DatabricksVolumesConnectorConfigInput",
+		Const:       "ConnectorTypeDatabricksVolumes",
+		Type:        "databricks_volumes",
+		Label:       "Databricks Volumes",
+		Source:      true,
+		Destination: true,
+		NoFactory:   true,
+		Schemas:     []string{"DatabricksVolumesConnectorConfigInput"},
+		Doc: `represents the configuration for a Databricks Volumes connector.
+It contains host details, catalog information, and authentication credentials.`,
+	},
+	{
+		Name:        "ElasticsearchConnectorConfigInput",
+		Const:       "ConnectorTypeElasticsearch",
+		Type:        "elasticsearch",
+		Label:       "Elasticsearch",
+		Source:      true,
+		Destination: true,
+		NoFactory:   true,
+		Schemas:     []string{"ElasticsearchConnectorConfigInput"},
+		Doc: `represents the configuration for an Elasticsearch connector.
+It contains host details, index information, and API key authentication.`,
+	},
+	{
+		Name:        "MongoDBConnectorConfigInput",
+		Const:       "ConnectorTypeMongoDB",
+		Type:        "mongodb",
+		Label:       "MongoDB",
+		Source:      true,
+		Destination: true,
+		NoFactory:   true,
+		Schemas:     []string{"MongoDBConnectorConfigInput"},
+		Doc: `represents the configuration for a MongoDB connector.
+It contains database connection details and collection information.`,
+	},
+	{
+		Name:        "DatabricksVolumesConnectorConfig",
+		Const:       "ConnectorTypeDatabricksVolumes",
+		Type:        "databricks_volumes",
+		Label:       "Databricks Volumes",
+		Source:      true,
+		Destination: true,
+		Schemas:     []string{"DatabricksVolumesConnectorConfigInput"},
+		Doc: `represents the configuration for a Databricks Volumes connector.
+It contains host details, catalog information, and authentication credentials.`,
+	},
+	{
+		Name:        "ElasticsearchConnectorConfig",
+		Const:       "ConnectorTypeElasticsearch",
+		Type:        "elasticsearch",
+		Label:       "Elasticsearch",
+		Source:      true,
+		Destination: true,
+		Schemas:     []string{"ElasticsearchConnectorConfigInput"},
+		Doc: `represents the configuration for an Elasticsearch connector.
+It contains host details, index information, and API key authentication.`,
+	},
+	{
+		Name:        "MongoDBConnectorConfig",
+		Const:       "ConnectorTypeMongoDB",
+		Type:        "mongodb",
+		Label:       "MongoDB",
+		Source:      true,
+		Destination: true,
+		Schemas:     []string{"MongoDBConnectorConfigInput"},
+		Doc: `represents the configuration for a MongoDB connector.
+It contains database connection details and collection information.`,
+	},
+	{
+		Name:        "CouchbaseConnectorConfig",
+		Const:       "ConnectorTypeCouchbase",
+		Type:        "couchbase",
+		Label:       "Couchbase",
+		Source:      true,
+		Destination: true,
+		Schemas:     []string{"CouchbaseSourceConnectorConfigInput", "CouchbaseDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a Couchbase connector.
+It contains connection details, bucket information, and authentication credentials.`,
+	},
+	{
+		Name:        "S3ConnectorConfig",
+		Const:       "ConnectorTypeS3",
+		Type:        "s3",
+		Label:       "S3",
+		Source:      true,
+		Destination: true,
+		Schemas:     []string{"S3SourceConnectorConfigInput", "S3DestinationConnectorConfigInput"},
+		Doc: `represents the configuration for an S3 connector.
+It supports both AWS S3 and S3-compatible storage services.`,
+	},
+	{
+		Name:        "GCSConnectorConfig",
+		Const:       "ConnectorTypeGCS",
+		Type:        "gcs",
+		Label:       "GCS",
+		Source:      true,
+		Destination: true,
+		Schemas:     []string{"GCSSourceConnectorConfigInput", "GCSDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a Google Cloud Storage connector.
+It contains the remote URL and service account key for authentication.`,
+	},
+	{
+		Name:        "KafkaCloudConnectorConfig",
+		Const:       "ConnectorTypeKafkaCloud",
+		Type:        "kafka-cloud",
+		Label:       "Kafka Cloud",
+		Source:      true,
+		Destination: true,
+		Schemas:     []string{"KafkaCloudSourceConnectorConfigInput", "KafkaCloudDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a Kafka Cloud connector.
+It contains broker details, topic information, and authentication credentials.`,
+	},
+	{
+		Name:        "PostgresConnectorConfig",
+		Const:       "ConnectorTypePostgres",
+		Type:        "postgres",
+		Label:       "PostgreSQL",
+		Source:      true,
+		Destination: true,
+		Schemas:     []string{"PostgresSourceConnectorConfigInput", "PostgresDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a PostgreSQL connector.
+It contains database connection details and table configuration.`,
+	},
+	{
+		Name:        "SnowflakeConnectorConfig",
+		Const:       "ConnectorTypeSnowflake",
+		Type:        "snowflake",
+		Label:       "Snowflake",
+		Source:      true,
+		Destination: true,
+		Schemas:     []string{"SnowflakeSourceConnectorConfigInput", "SnowflakeDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a Snowflake connector.
+It contains account details, authentication, and table configuration.`,
+	},
+	{
+		Name:        "OneDriveConnectorConfig",
+		Const:       "ConnectorTypeOneDrive",
+		Type:        "onedrive",
+		Label:       "OneDrive",
+		Source:      true,
+		Destination: true,
+		Schemas:     []string{"OneDriveSourceConnectorConfigInput", "OneDriveDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a OneDrive connector.
+It contains Microsoft Graph API authentication and file access settings.`,
+	},
+
+	// source connectors.
+	{
+		Name:    "AzureSourceConnectorConfig",
+		Const:   "ConnectorTypeAzure",
+		Type:    "azure",
+		Label:   "Azure",
+		Source:  true,
+		Schemas: []string{"AzureSourceConnectorConfigInput"},
+		Doc: `represents the configuration for an Azure Blob Storage source connector.
+It supports authentication via connection string, account key, or SAS token.`,
+	},
+	{
+		Name:    "BoxSourceConnectorConfig",
+		Const:   "ConnectorTypeBox",
+		Type:    "box",
+		Label:   "Box",
+		Source:  true,
+		Schemas: []string{"BoxSourceConnectorConfigInput"},
+		Doc: `represents the configuration for a Box source connector.
+It contains Box app configuration and file access settings.`,
+	},
+	{
+		Name:    "ConfluenceSourceConnectorConfig",
+		Const:   "ConnectorTypeConfluence",
+		Type:    "confluence",
+		Label:   "Confluence",
+		Source:  true,
+		Schemas: []string{"ConfluenceSourceConnectorConfigInput"},
+		Doc: `represents the configuration for a Confluence source connector.
+It contains authentication details and content extraction settings.`,
+	},
+	{
+		Name:    "JiraSourceConnectorConfig",
+		Const:   "ConnectorTypeJira",
+		Type:    "jira",
+		Label:   "Jira",
+		Source:  true,
+		Schemas: []string{"JiraSourceConnectorConfigInput"},
+		Doc: `represents the configuration for a Jira source connector.
+It contains authentication details and project/issue filtering settings.`,
+	},
+	{
+		Name:    "SharePointSourceConnectorConfig",
+		Const:   "ConnectorTypeSharePoint",
+		Type:    "sharepoint",
+		Label:   "SharePoint",
+		Source:  true,
+		Schemas: []string{"SharePointSourceConnectorConfigInput"},
+		Doc: `represents the configuration for a SharePoint source connector.
+It contains Microsoft Graph API authentication and site access details.`,
+	},
+	{
+		Name:    "DropboxSourceConnectorConfig",
+		Const:   "ConnectorTypeDropbox",
+		Type:    "dropbox",
+		Label:   "Dropbox",
+		Source:  true,
+		Schemas: []string{"DropboxSourceConnectorConfigInput"},
+		Doc: `represents the configuration for a Dropbox source connector.
+It contains access token and file path configuration.`,
+	},
+	{
+		Name:    "GoogleDriveSourceConnectorConfig",
+		Const:   "ConnectorTypeGoogleDrive",
+		Type:    "google_drive",
+		Label:   "Google Drive",
+		Source:  true,
+		Schemas: []string{"GoogleDriveSourceConnectorConfigInput"},
+		Doc: `represents the configuration for a Google Drive source connector.
+It contains drive ID, service account key, and file filtering settings.`,
+		Fields: map[string]override{
+			// kept optional so that existing callers keep compiling; secret references are not supported.
+			"service_account_key": {Optional: true},
+		},
+	},
+	{
+		Name:    "OutlookSourceConnectorConfig",
+		Const:   "ConnectorTypeOutlook",
+		Type:    "outlook",
+		Label:   "Outlook",
+		Source:  true,
+		Schemas: []string{"OutlookSourceConnectorConfigInput"},
+		Doc: `represents the configuration for an Outlook source connector.
+It contains Microsoft Graph API authentication and email folder settings.`,
+	},
+	{
+		Name:    "SalesforceSourceConnectorConfig",
+		Const:   "ConnectorTypeSalesforce",
+		Type:    "salesforce",
+		Label:   "Salesforce",
+		Source:  true,
+		Schemas: []string{"SalesforceSourceConnectorConfigInput"},
+		Doc: `represents the configuration for a Salesforce source connector.
+It contains authentication details and data category filtering.`,
+	},
+	{
+		Name:   "SlackSourceConnectorConfig",
+		Const:  "ConnectorTypeSlack",
+		Type:   "slack",
+		Label:  "Slack",
+		Source: true,
+	},
+	{
+		Name:    "ZendeskSourceConnectorConfig",
+		Const:   "ConnectorTypeZendesk",
+		Type:    "zendesk",
+		Label:   "Zendesk",
+		Source:  true,
+		Schemas: []string{"ZendeskSourceConnectorConfigInput"},
+		Doc: `represents the configuration for a Zendesk source connector.
+It contains subdomain, authentication, and item type filtering.`,
+	},
+
+	// destination connectors.
+	{
+		Name:        "AstraDBConnectorConfig",
+		Const:       "ConnectorTypeAstraDB",
+		Type:        "astradb",
+		Label:       "AstraDB",
+		Destination: true,
+		Schemas:     []string{"AstraDBConnectorConfigInput"},
+		Doc: `represents the configuration for an AstraDB destination connector.
+It contains the collection name, keyspace, batch size, API endpoint, and token.`,
+	},
+	{
+		Name:        "AzureAISearchConnectorConfig",
+		Const:       "ConnectorTypeAzureAISearch",
+		Type:        "azure_ai_search",
+		Label:       "Azure AI Search",
+		Destination: true,
+		Schemas:     []string{"AzureAISearchConnectorConfigInput"},
+		Doc: `represents the configuration for an Azure AI Search destination connector.
+It contains the endpoint, index name, and API key.`,
+	},
+	{
+		Name:        "DatabricksVDTDestinationConnectorConfig",
+		Const:       "ConnectorTypeDatabricksVolumeDeltaTable",
+		Type:        "databricks_volume_delta_tables",
+		Label:       "Databricks Volume Delta Tables",
+		Destination: true,
+		Schemas:     []string{"DatabricksVDTDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a Databricks Volume Delta Tables destination connector.
+It contains server details, authentication, and table configuration.`,
+	},
+	{
+		Name:        "DeltaTableConnectorConfig",
+		Const:       "ConnectorTypeDeltaTable",
+		Type:        "delta_table",
+		Label:       "Delta Table",
+		Destination: true,
+		Schemas:     []string{"DeltaTableConnectorConfigInput"},
+		Doc: `represents the configuration for a Delta Table destination connector.
+It contains AWS credentials and table URI for Delta Lake storage.`,
+	},
+	{
+		Name:        "MilvusDestinationConnectorConfig",
+		Const:       "ConnectorTypeMilvus",
+		Type:        "milvus",
+		Label:       "Milvus",
+		Destination: true,
+		Schemas:     []string{"MilvusDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a Milvus destination connector.
+It contains connection details, collection information, and authentication.`,
+	},
+	{
+		Name:        "Neo4jDestinationConnectorConfig",
+		Const:       "ConnectorTypeNeo4j",
+		Type:        "neo4j",
+		Label:       "Neo4j",
+		Destination: true,
+		Schemas:     []string{"Neo4jDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a Neo4j destination connector.
+It contains database connection details and authentication credentials.`,
+	},
+	{
+		Name:        "MotherduckDestinationConnectorConfig",
+		Const:       "ConnectorTypeMotherDuck",
+		Type:        "motherduck",
+		Label:       "MotherDuck",
+		Destination: true,
+	},
+	{
+		Name:        "PineconeDestinationConnectorConfig",
+		Const:       "ConnectorTypePinecone",
+		Type:        "pinecone",
+		Label:       "Pinecone",
+		Destination: true,
+		Schemas:     []string{"PineconeDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a Pinecone destination connector.
+It contains index details, API key, and namespace information.`,
+	},
+	{
+		Name:        "RedisDestinationConnectorConfig",
+		Const:       "ConnectorTypeRedis",
+		Type:        "redis",
+		Label:       "Redis",
+		Destination: true,
+		Schemas:     []string{"RedisDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a Redis destination connector.
+It contains connection details, database selection, and authentication.`,
+	},
+	{
+		Name:        "QdrantCloudDestinationConnectorConfig",
+		Const:       "ConnectorTypeQdrantCloud",
+		Type:        "qdrant-cloud",
+		Label:       "Qdrant Cloud",
+		Destination: true,
+		Schemas:     []string{"QdrantCloudDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a Qdrant Cloud destination connector.
+It contains API endpoint, collection details, and authentication.`,
+	},
+	{
+		Name:        "WeaviateDestinationConnectorConfig",
+		Const:       "ConnectorTypeWeaviateCloud",
+		Type:        "weaviate-cloud",
+		Label:       "Weaviate Cloud",
+		Destination: true,
+		Schemas:     []string{"WeaviateDestinationConnectorConfigInput"},
+		Doc: `represents the configuration for a Weaviate destination connector.
+It contains cluster URL, API key, and collection information.`,
+	},
+	{
+		Name:        "IBMWatsonxS3DestinationConnectorConfig",
+		Const:       "ConnectorTypeIBMWatsonxS3",
+		Type:        "ibm_watsonx_s3",
+		Label:       "IBM Watsonx S3",
+		Destination: true,
+		Schemas:     []string{"IBMWatsonxS3DestinationConnectorConfigInput"},
+		Doc: `represents the configuration for an IBM Watsonx S3 destination connector.
+It contains IBM Cloud authentication, storage endpoints, and table configuration.`,
+		Fields: map[string]override{
+			"iam_api_key": {Name: "IAMApiKey"},
+		},
+	},
+}
@@ -30,13 +30,9 @@ func (c *Client) DownloadJob(ctx context.Context, in DownloadJobRequest) (io.Rea
 	q.Add("file_id", in.FileID)
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.hc.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to download job: %s", resp.Status)
+		return nil, fmt.Errorf("failed to download job: %w", err)
 	}
 
 	return resp.Body, nil
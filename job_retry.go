@@ -0,0 +1,301 @@
+package unstructured
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"time"
+)
+
+// FileResolver maps the document names reported by [Client.GetJobFailedFiles] back to the files that were submitted.
+type FileResolver interface {
+	Resolve(document string) (File, error)
+}
+
+// FileResolverFunc is a function that implements [FileResolver].
+type FileResolverFunc func(document string) (File, error)
+
+// Resolve calls f(document).
+func (f FileResolverFunc) Resolve(document string) (File, error) { return f(document) }
+
+// FSResolver returns a [FileResolver] that opens documents in fsys.
+// A document is looked up by its full name first and then by its base name,
+// so files uploaded from a flat directory are found even if the API reports them with a path.
+func FSResolver(fsys fs.FS) FileResolver {
+	return FileResolverFunc(func(document string) (File, error) {
+		var errs []error
+
+		for _, name := range []string{document, path.Base(document)} {
+			if !fs.ValidPath(name) {
+				continue
+			}
+
+			f, err := fsys.Open(name)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			return &resolvedFile{File: f, name: document}, nil
+		}
+
+		return nil, fmt.Errorf("failed to resolve %s: %w", document, errors.Join(errs...))
+	})
+}
+
+// DirResolver returns a [FileResolver] that opens documents in the local directory dir, see [FSResolver].
+func DirResolver(dir string) FileResolver {
+	return FSResolver(os.DirFS(dir))
+}
+
+// resolvedFile is an opened file that is uploaded under the document name it was resolved from.
+type resolvedFile struct {
+	fs.File
+	name string
+}
+
+func (f *resolvedFile) Name() string { return f.name }
+
+// RetryFailedFilesRequest represents a request to re-run the files that failed in a job.
+type RetryFailedFilesRequest struct {
+	// JobID is the finished job whose failed files are retried.
+	JobID string
+
+	// Resolver finds the files to submit again.
+	Resolver FileResolver
+
+	// MaxRetries is how many retry jobs are run at most, each one for the files that failed in the previous job.
+	// It defaults to 1.
+	MaxRetries int
+
+	// MinBackoff and MaxBackoff control the delay between retry jobs, like those of [RetryPolicy].
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// RetryFailedFilesReport is the combined outcome of the retries of a job's failed files.
+type RetryFailedFilesReport struct {
+	JobID      string
+	WorkflowID string
+
+	// Attempts lists the retry jobs in the order they ran.
+	Attempts []RetryAttempt
+
+	// Recovered lists the documents that succeeded in one of the retries.
+	Recovered []string
+
+	// Failed lists the documents that still failed after the last retry, with their last error,
+	// including those whose retry job failed without output or saying which documents it failed on.
+	Failed []FailedFile
+
+	// Unresolved lists the documents that the resolver could not find, which were not retried.
+	Unresolved []FailedFile
+}
+
+// RetryAttempt is a job that retried the failed files of a previous job.
+type RetryAttempt struct {
+	JobID string
+
+	// RetriedJobID is the job whose failed files this job retried,
+	// which links the retries to each other and to the original job.
+	RetriedJobID string
+
+	Documents   []string
+	Status      JobStatus
+	FailedFiles []FailedFile
+}
+
+// RetryFailedFiles submits the files that failed in a finished job to the job's workflow again,
+// waits for the new job to finish, and repeats with the files that still fail,
+// up to in.MaxRetries times with a backoff between jobs.
+// The report lists each retry job, linked to the job it retried, and which documents were recovered.
+// If an error stops the retries, the report of the retries so far is returned with it.
+func (c *Client) RetryFailedFiles(ctx context.Context, in RetryFailedFilesRequest) (*RetryFailedFilesReport, error) {
+	if in.Resolver == nil {
+		return nil, errors.New("a file resolver is required to retry failed files")
+	}
+
+	job, err := c.GetJob(ctx, in.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Status == JobStatusScheduled || job.Status == JobStatusInProgress {
+		return nil, fmt.Errorf("job %s is still %s", job.ID, job.Status)
+	}
+
+	failed, err := c.GetJobFailedFiles(ctx, job.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := RetryFailedFilesReport{
+		JobID:      job.ID,
+		WorkflowID: job.WorkflowID,
+		Failed:     failed.FailedFiles,
+	}
+
+	backoff := RetryPolicy{MinBackoff: in.MinBackoff, MaxBackoff: in.MaxBackoff}
+
+	for retry := 1; retry <= max(in.MaxRetries, 1) && len(report.Failed) > 0; retry++ {
+		if retry > 1 {
+			timer := time.NewTimer(backoff.backoff(retry-1, nil))
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return &report, fmt.Errorf("failed to wait before retrying failed files: %w", ctx.Err())
+			case <-timer.C:
+			}
+		}
+
+		previous := job.ID
+
+		if job, err = c.retryFiles(ctx, &report, in.Resolver, job.ID); err != nil || job == nil {
+			return &report, err
+		}
+
+		if job.Status == JobStatusStopped {
+			return &report, fmt.Errorf("retry job %s of job %s was stopped", job.ID, previous)
+		}
+	}
+
+	return &report, nil
+}
+
+// retryFiles runs a job for the report's failed files that can be resolved and records its outcome.
+// It returns nil if none of the files could be resolved.
+func (c *Client) retryFiles(
+	ctx context.Context,
+	report *RetryFailedFilesReport,
+	resolver FileResolver,
+	jobID string,
+) (*Job, error) {
+	attempt := RetryAttempt{RetriedJobID: jobID}
+
+	var files []File
+
+	defer func() {
+		for _, f := range files {
+			if closer, ok := f.(io.Closer); ok {
+				_ = closer.Close()
+			}
+		}
+	}()
+
+	var resolved []FailedFile
+
+	for _, failed := range report.Failed {
+		f, err := resolver.Resolve(failed.Document)
+		if err != nil {
+			report.Unresolved = append(report.Unresolved, FailedFile{Document: failed.Document, Error: err.Error()})
+			continue
+		}
+
+		files = append(files, f)
+		resolved = append(resolved, failed)
+		attempt.Documents = append(attempt.Documents, failed.Document)
+	}
+
+	// the resolved files stay failed until a job is running for them.
+	report.Failed = resolved
+
+	if len(files) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	job, err := c.RunWorkflow(ctx, &RunWorkflowRequest{ID: report.WorkflowID, InputFiles: files})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retry failed files of job %s: %w", jobID, err)
+	}
+
+	report.Failed = nil
+
+	attempt.JobID = job.ID
+
+	if job, err = c.waitJob(ctx, job); err != nil {
+		report.Attempts = append(report.Attempts, attempt)
+		return nil, err
+	}
+
+	attempt.Status = job.Status
+
+	failed, err := c.GetJobFailedFiles(ctx, job.ID)
+	if err != nil {
+		report.Attempts = append(report.Attempts, attempt)
+		return nil, err
+	}
+
+	attempt.FailedFiles = failed.FailedFiles
+
+	// a failed job may not list the files it failed on, in which case none of them succeeded.
+	if job.Status == JobStatusFailed && len(failed.FailedFiles) == 0 {
+		for _, doc := range attempt.Documents {
+			attempt.FailedFiles = append(attempt.FailedFiles, FailedFile{
+				Document: doc,
+				Error:    "job " + job.ID + " failed",
+			})
+		}
+	}
+
+	stillFailed := make(map[string]bool)
+	for _, f := range attempt.FailedFiles {
+		stillFailed[f.Document] = true
+	}
+
+	// every retried document is either recovered or failed: a document that the job did not list as failed
+	// succeeded if the job completed, or if it failed on other documents but still produced output.
+	for _, doc := range attempt.Documents {
+		switch {
+		case stillFailed[doc]:
+		case job.Status == JobStatusCompleted || len(job.OutputNodeFiles) > 0:
+			report.Recovered = append(report.Recovered, doc)
+		default:
+			attempt.FailedFiles = append(attempt.FailedFiles, FailedFile{
+				Document: doc,
+				Error:    fmt.Sprintf("job %s ended %s without output, so the outcome of the document is unknown", job.ID, job.Status),
+			})
+		}
+	}
+
+	report.Failed = attempt.FailedFiles
+	report.Attempts = append(report.Attempts, attempt)
+
+	return job, nil
+}
+
+// waitJob polls the job at the client's poll interval until it is no longer scheduled or in progress.
+func (c *Client) waitJob(ctx context.Context, job *Job) (*Job, error) {
+	ctx, span := c.startSpan(ctx, "WaitJob", Attribute{"unstructured.job.id", job.ID})
+	defer span.End()
+
+	ticker := time.NewTicker(c.poll)
+	defer ticker.Stop()
+
+	for job.Status == JobStatusScheduled || job.Status == JobStatusInProgress {
+		select {
+		case <-ctx.Done():
+			span.RecordError(ctx.Err())
+			return nil, fmt.Errorf("failed to wait for job %s: %w", job.ID, ctx.Err())
+		case <-ticker.C:
+		}
+
+		next, err := c.GetJob(ctx, job.ID)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+
+		if next.Status != job.Status {
+			span.AddEvent("status change", Attribute{"unstructured.job.status", string(next.Status)})
+		}
+
+		job = next
+	}
+
+	return job, nil
+}
@@ -0,0 +1,254 @@
+package unstructured
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go/test"
+)
+
+func TestRetryFailedFiles(t *testing.T) {
+	t.Parallel()
+
+	fake := test.NewFakeServer()
+
+	// every poll of a job moves the clock on, so that retry jobs finish while they are waited for.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v1/jobs/") {
+			fake.Advance(20 * time.Second)
+		}
+
+		fake.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := New(
+		WithClient(server.Client()),
+		WithEndpoint(server.URL+"/api/v1"),
+		WithKey(test.FakeAPIKey),
+		WithPollInterval(time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	ctx := testContext(t)
+
+	workflow, err := client.CreateWorkflow(ctx, &CreateWorkflowRequest{
+		Name:          "ingest",
+		WorkflowNodes: []WorkflowNode{&PartitionerFast{Name: "Partitioner"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create workflow: %v", err)
+	}
+
+	fake.FailFile("broken.txt", "unsupported file")
+	fake.FailFile("flaky.txt", "timed out")
+	fake.FailFile("missing.txt", "timed out")
+
+	job, err := client.RunWorkflow(ctx, &RunWorkflowRequest{
+		ID: workflow.ID,
+		InputFiles: []File{
+			&FileBytes{Filename: "hello.txt", Bytes: strings.NewReader("Hello.")},
+			&FileBytes{Filename: "broken.txt", Bytes: strings.NewReader("???")},
+			&FileBytes{Filename: "flaky.txt", Bytes: strings.NewReader("Flaky.")},
+			&FileBytes{Filename: "missing.txt", Bytes: strings.NewReader("Gone.")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to run workflow: %v", err)
+	}
+
+	resolver := FSResolver(fstest.MapFS{
+		"broken.txt":     {Data: []byte("???")},
+		"docs/flaky.txt": {Data: []byte("Flaky.")},
+	})
+
+	if _, err := client.RetryFailedFiles(ctx, RetryFailedFilesRequest{JobID: job.ID, Resolver: resolver}); err == nil {
+		t.Error("expected an error retrying a job that is still running")
+	}
+
+	fake.Advance(time.Minute)
+	fake.FailFile("flaky.txt", "")
+
+	if _, err := client.RetryFailedFiles(ctx, RetryFailedFilesRequest{JobID: job.ID}); err == nil {
+		t.Error("expected an error retrying without a resolver")
+	}
+
+	report, err := client.RetryFailedFiles(ctx, RetryFailedFilesRequest{
+		JobID:      job.ID,
+		Resolver:   FSResolver(fstest.MapFS{"broken.txt": {Data: []byte("???")}, "flaky.txt": {Data: []byte("Flaky.")}}),
+		MaxRetries: 2,
+		MinBackoff: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("failed to retry failed files: %v", err)
+	}
+
+	if len(report.Attempts) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(report.Attempts))
+	}
+
+	first, second := report.Attempts[0], report.Attempts[1]
+
+	if err := errors.Join(
+		eq("workflow", report.WorkflowID, workflow.ID),
+		eq("first retried job", first.RetriedJobID, job.ID),
+		eqs("first documents", first.Documents, []string{"broken.txt", "flaky.txt"}),
+		eq("first status", first.Status, JobStatusCompleted),
+		eq("second retried job", second.RetriedJobID, first.JobID),
+		eqs("second documents", second.Documents, []string{"broken.txt"}),
+		eq("second status", second.Status, JobStatusFailed),
+		eqs("recovered", report.Recovered, []string{"flaky.txt"}),
+		eqs("failed", report.Failed, []FailedFile{{Document: "broken.txt", Error: "unsupported file"}}),
+		eq("len(unresolved)", len(report.Unresolved), 1),
+	); err != nil {
+		t.Error(err)
+	}
+
+	if len(report.Unresolved) == 1 && report.Unresolved[0].Document != "missing.txt" {
+		t.Errorf("expected missing.txt to be unresolved, got %s", report.Unresolved[0].Document)
+	}
+
+	f, err := resolver.Resolve("input/docs/flaky.txt")
+	if err == nil {
+		t.Errorf("expected only the full name and base name to be resolved, got %s", f.Name())
+	}
+
+	if f, err = resolver.Resolve("docs/flaky.txt"); err != nil || f.Name() != "docs/flaky.txt" {
+		t.Errorf("expected docs/flaky.txt to resolve by its full name, got %v", err)
+	}
+}
+
+func TestRetryFailedFilesPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	const (
+		jobID      = "11111111-1111-4111-8111-111111111111"
+		retryJobID = "22222222-2222-4222-8222-222222222222"
+	)
+
+	for _, tt := range []struct {
+		name      string
+		outputs   string
+		recovered []string
+		failed    []FailedFile
+	}{
+		{
+			name:      "with output",
+			outputs:   `, "output_node_files": [{"node_id": "n", "file_id": "f"}]`,
+			recovered: []string{"b.txt", "c.txt"},
+			failed:    []FailedFile{{Document: "a.txt", Error: "still broken"}},
+		},
+		{
+			name: "without output",
+			failed: []FailedFile{
+				{Document: "a.txt", Error: "still broken"},
+				{Document: "b.txt", Error: "job " + retryJobID + " ended FAILED without output, so the outcome of the document is unknown"},
+				{Document: "c.txt", Error: "job " + retryJobID + " ended FAILED without output, so the outcome of the document is unknown"},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux := testclient(t)
+
+			mux.GetJob = func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				if r.PathValue("id") == jobID {
+					w.Write([]byte(`{"id": "` + jobID + `", "workflow_id": "w", "status": "FAILED"}`))
+					return
+				}
+
+				w.Write([]byte(`{"id": "` + retryJobID + `", "workflow_id": "w", "status": "FAILED"` + tt.outputs + `}`))
+			}
+
+			mux.RunWorkflow = func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id": "` + retryJobID + `", "workflow_id": "w", "status": "FAILED"` + tt.outputs + `}`))
+			}
+
+			mux.GetJobFailedFiles = func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				if r.PathValue("id") == jobID {
+					w.Write([]byte(`{"failed_files": [` +
+						`{"document": "a.txt", "error": "broken"},` +
+						`{"document": "b.txt", "error": "broken"},` +
+						`{"document": "c.txt", "error": "broken"}]}`))
+
+					return
+				}
+
+				w.Write([]byte(`{"failed_files": [{"document": "a.txt", "error": "still broken"}]}`))
+			}
+
+			report, err := client.RetryFailedFiles(testContext(t), RetryFailedFilesRequest{
+				JobID: jobID,
+				Resolver: FSResolver(fstest.MapFS{
+					"a.txt": {Data: []byte("a")},
+					"b.txt": {Data: []byte("b")},
+					"c.txt": {Data: []byte("c")},
+				}),
+			})
+			if err != nil {
+				t.Fatalf("failed to retry failed files: %v", err)
+			}
+
+			if err := errors.Join(
+				eqs("recovered", report.Recovered, tt.recovered),
+				eqs("failed", report.Failed, tt.failed),
+			); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRetryFailedFilesRunError(t *testing.T) {
+	t.Parallel()
+
+	const jobID = "11111111-1111-4111-8111-111111111111"
+
+	client, mux := testclient(t)
+
+	mux.GetJob = func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "` + jobID + `", "workflow_id": "w", "status": "FAILED"}`))
+	}
+
+	mux.GetJobFailedFiles = func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"failed_files": [` +
+			`{"document": "a.txt", "error": "broken"},` +
+			`{"document": "b.txt", "error": "broken"}]}`))
+	}
+
+	mux.RunWorkflow = func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"detail": "workflow is busy"}`, http.StatusConflict)
+	}
+
+	report, err := client.RetryFailedFiles(testContext(t), RetryFailedFilesRequest{
+		JobID:    jobID,
+		Resolver: FSResolver(fstest.MapFS{"a.txt": {Data: []byte("a")}}),
+	})
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected error to be %v, got %v", ErrConflict, err)
+	}
+
+	// the file that could not be submitted is still failed, not lost.
+	if err := errors.Join(
+		eqs("failed", report.Failed, []FailedFile{{Document: "a.txt", Error: "broken"}}),
+		eq("len(unresolved)", len(report.Unresolved), 1),
+		eq("len(attempts)", len(report.Attempts), 0),
+	); err != nil {
+		t.Error(err)
+	}
+}
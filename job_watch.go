@@ -0,0 +1,164 @@
+package unstructured
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// JobProgress is a snapshot of a job, as reported by [Client.WatchJob].
+type JobProgress struct {
+	Job     *Job        `json:"job"`
+	Details *JobDetails `json:"details"`
+
+	// FailedFiles lists every file that failed so far, and NewFailedFiles the ones
+	// that were not in the previous snapshot.
+	FailedFiles    []FailedFile `json:"failed_files"`
+	NewFailedFiles []FailedFile `json:"new_failed_files"`
+
+	// Processed and Total count the files that finished, successfully or not, and all files, summed over the nodes.
+	Processed int `json:"processed"`
+	Total     int `json:"total"`
+
+	// Elapsed is the job's runtime as reported by the API, or, while the API does not report it,
+	// the time since the job was created.
+	Elapsed time.Duration `json:"elapsed"`
+
+	// ETA estimates how long the job will keep running, assuming the remaining files take as long as the processed ones.
+	// It is zero when there is nothing to estimate from yet, and once the job is done.
+	ETA time.Duration `json:"eta"`
+
+	// Done reports whether the job has stopped running, whatever its final status.
+	Done bool `json:"done"`
+}
+
+// Fraction returns the share of files that finished processing, from 0 to 1.
+func (p *JobProgress) Fraction() float64 {
+	if p.Total == 0 {
+		return 0
+	}
+
+	return float64(p.Processed) / float64(p.Total)
+}
+
+// WatchJob polls the job with the given ID, its details and its failed files until the job
+// is no longer scheduled or in progress, calling fn with a snapshot after each poll.
+// The job is polled at the client's poll interval, see [WithPollInterval].
+// If fn returns an error, watching stops and WatchJob returns that error.
+func (c *Client) WatchJob(ctx context.Context, id string, fn func(*JobProgress) error) error {
+	ctx, span := c.startSpan(ctx, "WaitJob", Attribute{"unstructured.job.id", id})
+	defer span.End()
+
+	ticker := time.NewTicker(c.poll)
+	defer ticker.Stop()
+
+	seen := make(map[FailedFile]bool)
+
+	var status JobStatus
+
+	for {
+		p, err := c.jobProgress(ctx, id, seen)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		if p.Job.Status != status {
+			status = p.Job.Status
+			span.AddEvent("status change", Attribute{"unstructured.job.status", string(status)})
+		}
+
+		if err := fn(p); err != nil {
+			return err
+		}
+
+		if p.Done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			span.RecordError(ctx.Err())
+			return fmt.Errorf("failed to watch job: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) jobProgress(ctx context.Context, id string, seen map[FailedFile]bool) (*JobProgress, error) {
+	job, err := c.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := c.GetJobDetails(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	failed, err := c.GetJobFailedFiles(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	p := JobProgress{
+		Job:         job,
+		Details:     details,
+		FailedFiles: failed.FailedFiles,
+		Done:        job.Status != JobStatusScheduled && job.Status != JobStatusInProgress,
+	}
+
+	for _, f := range failed.FailedFiles {
+		if !seen[f] {
+			seen[f] = true
+
+			p.NewFailedFiles = append(p.NewFailedFiles, f)
+		}
+	}
+
+	for _, n := range details.NodeStats {
+		p.Processed += n.Success + n.Failure
+		p.Total += n.Ready + n.InProgress + n.Success + n.Failure
+	}
+
+	if d, ok := parseDuration(ToString(job.Runtime)); ok {
+		p.Elapsed = d
+	} else if !job.CreatedAt.IsZero() {
+		p.Elapsed = max(time.Since(job.CreatedAt), 0)
+	}
+
+	if !p.Done && p.Processed > 0 && p.Elapsed > 0 {
+		p.ETA = time.Duration(float64(p.Elapsed) * float64(p.Total-p.Processed) / float64(p.Processed))
+	}
+
+	return &p, nil
+}
+
+var isoDuration = regexp.MustCompile(`^P(?:(\d+(?:\.\d+)?)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseDuration parses a duration as returned by the API, which uses ISO 8601 durations like "PT1M30.5S".
+func parseDuration(s string) (time.Duration, bool) {
+	m := isoDuration.FindStringSubmatch(s)
+	if m == nil || s == "P" || s == "PT" {
+		return 0, false
+	}
+
+	var d time.Duration
+
+	for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if m[i+1] == "" {
+			continue
+		}
+
+		v, err := strconv.ParseFloat(m[i+1], 64)
+		if err != nil {
+			return 0, false
+		}
+
+		d += time.Duration(v * float64(unit))
+	}
+
+	return d, true
+}
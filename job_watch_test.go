@@ -0,0 +1,105 @@
+package unstructured
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWatchJob(t *testing.T) {
+	t.Parallel()
+
+	client, fake := fakeclient(t)
+	ctx := testContext(t)
+
+	workflow, err := client.CreateWorkflow(ctx, &CreateWorkflowRequest{
+		Name:          "ingest",
+		WorkflowNodes: []WorkflowNode{&PartitionerFast{Name: "Partitioner"}, &ChunkerTitle{Name: "Chunker"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create workflow: %v", err)
+	}
+
+	fake.FailFile("broken.txt", "unsupported file")
+
+	job, err := client.RunWorkflow(ctx, &RunWorkflowRequest{
+		ID: workflow.ID,
+		InputFiles: []File{
+			&FileBytes{Filename: "hello.txt", Bytes: strings.NewReader("Hello.")},
+			&FileBytes{Filename: "broken.txt", Bytes: strings.NewReader("???")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to run workflow: %v", err)
+	}
+
+	var (
+		statuses []JobStatus
+		failed   []FailedFile
+		last     *JobProgress
+	)
+
+	err = client.WatchJob(ctx, job.ID, func(p *JobProgress) error {
+		statuses = append(statuses, p.Job.Status)
+		failed = append(failed, p.NewFailedFiles...)
+		last = p
+
+		fake.Advance(20 * time.Second)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to watch job: %v", err)
+	}
+
+	if err := errors.Join(
+		eqs("statuses", statuses, []JobStatus{JobStatusScheduled, JobStatusInProgress, JobStatusCompleted}),
+		eqs("new failed files", failed, []FailedFile{{Document: "broken.txt", Error: "unsupported file"}}),
+		eq("len(failed_files)", len(last.FailedFiles), 1),
+		eq("processed", last.Processed, 3),
+		eq("total", last.Total, 3),
+		eq("elapsed", last.Elapsed, 35*time.Second),
+		eq("eta", last.ETA, 0),
+		eq("done", last.Done, true),
+	); err != nil {
+		t.Error(err)
+	}
+
+	stop := errors.New("stop")
+
+	if err := client.WatchJob(ctx, job.ID, func(*JobProgress) error { return stop }); !errors.Is(err, stop) {
+		t.Errorf("expected the callback's error to stop watching, got %v", err)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]time.Duration{
+		"PT35S":          35 * time.Second,
+		"PT1M30.5S":      90*time.Second + 500*time.Millisecond,
+		"P1DT2H":         26 * time.Hour,
+		"PT0.000001S":    time.Microsecond,
+		"P2D":            48 * time.Hour,
+		"PT1H2M3S":       time.Hour + 2*time.Minute + 3*time.Second,
+		"":               -1,
+		"PT":             -1,
+		"35s":            -1,
+		"PT1S2M":         -1,
+		"P1Y2M10DT2H30M": -1,
+	} {
+		got, ok := parseDuration(in)
+		if want < 0 {
+			if ok {
+				t.Errorf("expected %q to be rejected, got %s", in, got)
+			}
+
+			continue
+		}
+
+		if !ok || got != want {
+			t.Errorf("expected %q to be %s, got %s (ok %t)", in, want, got, ok)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package unstructured
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go/internal/secret"
+)
+
+// WithLogger returns an Option that logs every request the client sends with l.
+//
+// Requests that succeed are logged at [slog.LevelDebug], with their method, path, status, duration and request ID.
+// Requests that fail are logged at [slog.LevelWarn] if the API rejected them with a 4xx status,
+// and at [slog.LevelError] if the API or the network failed, with the details of the [APIError] as attributes.
+// A logger whose handler is enabled for warnings and above thus only logs failures.
+// Each attempt of a retried request is logged.
+func WithLogger(l *slog.Logger) Option {
+	return func(c *Client) error {
+		c.logger = l
+		return nil
+	}
+}
+
+// WithLogBodies returns an Option that adds the request and response bodies to the logs of [WithLogger],
+// truncated to limit bytes each.
+// Secrets, like the values of the credential fields of connector configs and the passwords in URLs,
+// are redacted, and uploaded files and downloads are never logged.
+func WithLogBodies(limit int) Option {
+	return func(c *Client) error {
+		if limit <= 0 {
+			return fmt.Errorf("log body limit must be positive, got %d", limit)
+		}
+
+		c.logBodies = limit
+
+		return nil
+	}
+}
+
+// HeaderRequestID is the response header that holds the ID the API assigned to a request.
+const HeaderRequestID = "X-Request-Id"
+
+// logger is a [http.RoundTripper] that logs each request.
+type logger struct {
+	rt     http.RoundTripper
+	log    *slog.Logger
+	bodies int
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (l *logger) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+	}
+
+	if l.bodies > 0 && req.GetBody != nil && isJSON(req.Header.Get("Content-Type")) {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			_ = body.Close()
+
+			attrs = append(attrs, slog.String("request_body", l.redact(data)))
+		}
+	}
+
+	start := time.Now()
+	resp, err := l.rt.RoundTrip(req)
+	attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+	if err != nil {
+		l.log.LogAttrs(ctx, slog.LevelError, "request failed", append(attrs, slog.Any("error", err))...)
+		return nil, err //nolint:wrapcheck
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+
+	if id := resp.Header.Get(HeaderRequestID); id != "" {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+
+	if resp.StatusCode < http.StatusBadRequest {
+		if !l.log.Enabled(ctx, slog.LevelDebug) {
+			return resp, nil
+		}
+
+		if l.bodies > 0 && isJSON(resp.Header.Get("Content-Type")) {
+			attrs = append(attrs, slog.String("response_body", l.redact(peek(resp))))
+		}
+
+		l.log.LogAttrs(ctx, slog.LevelDebug, "request", attrs...)
+
+		return resp, nil
+	}
+
+	level := slog.LevelWarn
+	if resp.StatusCode >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+
+	if !l.log.Enabled(ctx, level) {
+		return resp, nil
+	}
+
+	// error responses are small, and the client reads all of them anyway.
+	body, readErr := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), errReader{readErr}))
+
+	if l.bodies > 0 {
+		attrs = append(attrs, slog.String("response_body", l.redact(body)))
+	}
+
+	attrs = append(attrs, slog.Any("error", newAPIError(resp, body)))
+
+	l.log.LogAttrs(ctx, level, "request failed", attrs...)
+
+	return resp, nil
+}
+
+// peek returns the response body without consuming it.
+// JSON responses are read in full by the client anyway, and the body must be whole to be redacted.
+func peek(resp *http.Response) []byte {
+	data, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(data), errReader{err}))
+
+	return data
+}
+
+// redact replaces the values of secret JSON fields, whatever their type, and credentials in URLs,
+// and truncates the body to the limit. Bodies that are not valid JSON are not logged,
+// since their secrets cannot be found reliably.
+func (l *logger) redact(body []byte) string {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("[%d bytes of invalid JSON]", len(body))
+	}
+
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(secret.Redact(v, secret.Fields, "[REDACTED]")); err != nil {
+		return fmt.Sprintf("[%d bytes]", len(body))
+	}
+
+	data := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+	if len(data) > l.bodies {
+		return string(data[:l.bodies]) + "..."
+	}
+
+	return string(data)
+}
+
+func isJSON(contentType string) bool {
+	return strings.HasPrefix(contentType, "application/json")
+}
+
+// LogValue implements [slog.LogValuer], logging the status and the details of the error as attributes.
+func (e *APIError) LogValue() slog.Value {
+	attrs := []slog.Attr{slog.Int("status", e.Code)}
+
+	var validation *HTTPValidationError
+	if errors.As(e.Err, &validation) {
+		attrs = append(attrs, slog.Any("validation", validation))
+	} else if e.Err != nil {
+		attrs = append(attrs, slog.String("message", e.Err.Error()))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue implements [slog.LogValuer], logging each validation error as a group of attributes.
+func (e *HTTPValidationError) LogValue() slog.Value {
+	attrs := make([]slog.Attr, len(e.Detail))
+
+	for i, d := range e.Detail {
+		loc := make([]string, len(d.Location))
+		for j, l := range d.Location {
+			loc[j] = fmt.Sprint(l)
+		}
+
+		attrs[i] = slog.Group(fmt.Sprint(i),
+			slog.String("loc", strings.Join(loc, ".")),
+			slog.String("msg", d.Message),
+			slog.String("type", d.Type),
+		)
+	}
+
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,185 @@
+package unstructured
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go/test"
+)
+
+func logclient(t *testing.T, level slog.Level, opts ...Option) (*Client, *bytes.Buffer) {
+	t.Helper()
+
+	server := httptest.NewServer(test.NewFakeServer())
+	t.Cleanup(server.Close)
+
+	var buf bytes.Buffer
+
+	c, err := New(append([]Option{
+		WithClient(server.Client()),
+		WithEndpoint(server.URL + "/api/v1"),
+		WithKey(test.FakeAPIKey),
+		WithLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: level}))),
+	}, opts...)...)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	return c, &buf
+}
+
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("failed to decode log record %q: %v", line, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func TestWithLogger(t *testing.T) {
+	t.Parallel()
+
+	client, buf := logclient(t, slog.LevelDebug, WithLogBodies(512))
+	ctx := testContext(t)
+
+	_, err := client.CreateSource(ctx, CreateSourceRequest{
+		Name:   "docs",
+		Config: &S3ConnectorConfig{RemoteURL: "s3://docs/input/", Key: String("AKIA1234"), Secret: String("hunter2")},
+	})
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+
+	if _, err := client.GetSource(ctx, "missing"); err == nil {
+		t.Fatal("expected an error getting a missing source")
+	}
+
+	if _, err := client.RunWorkflow(ctx, &RunWorkflowRequest{ID: "missing"}); err == nil {
+		t.Fatal("expected an error running a missing workflow")
+	}
+
+	if strings.Contains(buf.String(), "hunter2") || strings.Contains(buf.String(), "AKIA1234") {
+		t.Errorf("expected secrets to be redacted, got %s", buf)
+	}
+
+	if strings.Contains(buf.String(), test.FakeAPIKey) {
+		t.Errorf("expected the API key not to be logged, got %s", buf)
+	}
+
+	records := logRecords(t, buf)
+	if len(records) != 3 {
+		t.Fatalf("expected 3 log records, got %d: %s", len(records), buf)
+	}
+
+	create, missing := records[0], records[1]
+
+	if err := errors.Join(
+		eq("create level", create["level"], any("DEBUG")),
+		eq("create method", create["method"], any("POST")),
+		eq("create path", create["path"], any("/api/v1/sources/")),
+		eq("create status", create["status"], any(float64(200))),
+		eq("create redacted", strings.Contains(create["request_body"].(string), `"secret":"[REDACTED]"`), true),
+		eq("create response body", strings.Contains(create["response_body"].(string), `"name":"docs"`), true),
+		eq("missing level", missing["level"], any("WARN")),
+		eq("missing status", missing["status"], any(float64(404))),
+		eq("missing error status", missing["error"].(map[string]any)["status"], any(float64(404))),
+	); err != nil {
+		t.Error(err)
+	}
+
+	if _, ok := create["duration"]; !ok {
+		t.Error("expected the duration to be logged")
+	}
+}
+
+func TestWithLoggerQuiet(t *testing.T) {
+	t.Parallel()
+
+	client, buf := logclient(t, slog.LevelWarn)
+	ctx := testContext(t)
+
+	if _, err := client.ListSources(ctx, ""); err != nil {
+		t.Fatalf("failed to list sources: %v", err)
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("expected successful requests not to be logged at warn level, got %s", buf)
+	}
+
+	workflow, err := client.CreateWorkflow(ctx, &CreateWorkflowRequest{
+		Name:          "ingest",
+		WorkflowNodes: []WorkflowNode{&PartitionerFast{Name: "Partitioner"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create workflow: %v", err)
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("expected successful requests not to be logged at warn level, got %s", buf)
+	}
+
+	// a workflow without a source connector can only run with input files.
+	if _, err := client.RunWorkflow(ctx, &RunWorkflowRequest{ID: workflow.ID}); err == nil {
+		t.Fatal("expected an error running a workflow without input")
+	}
+
+	records := logRecords(t, buf)
+	if len(records) != 1 {
+		t.Fatalf("expected 1 log record, got %d: %s", len(records), buf)
+	}
+
+	if _, ok := records[0]["request_body"]; ok {
+		t.Error("expected bodies not to be logged without WithLogBodies")
+	}
+
+	apiErr, _ := records[0]["error"].(map[string]any)
+	validation, _ := apiErr["validation"].(map[string]any)
+	first, _ := validation["0"].(map[string]any)
+
+	if err := errors.Join(
+		eq("level", records[0]["level"], any("WARN")),
+		eq("status", apiErr["status"], any(float64(422))),
+		eq("loc", first["loc"], any("body.input_files")),
+	); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	l := logger{bodies: 40}
+
+	for in, want := range map[string]string{
+		`{"name":"docs"}`:                                   `{"name":"docs"}`,
+		`{"api_key": "abc", "n": 1}`:                        `{"api_key":"[REDACTED]","n":1}`,
+		`{"Password":"a\"b"}`:                               `{"Password":"[REDACTED]"}`,
+		`{"box_app_config":{"id":1}}`:                       `{"box_app_config":"[REDACTED]"}`,
+		`{"client_cred":[1]}`:                               `{"client_cred":"[REDACTED]"}`,
+		`{"uri":"mongodb://u:p@db/x"}`:                      `{"uri":"mongodb://[REDACTED]@db/x"}`,
+		`{"name":"docs","token":"abcdefgh`:                  `[32 bytes of invalid JSON]`,
+		`{"description":"` + strings.Repeat("x", 40) + `"}`: `{"description":"` + strings.Repeat("x", 24) + `...`,
+	} {
+		if got := l.redact([]byte(in)); got != want {
+			t.Errorf("redact(%s): expected %s, got %s", in, want, got)
+		}
+	}
+}
@@ -0,0 +1,645 @@
+package unstructured
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// openapiKnownDrift lists conformance problems that are accepted on purpose, with the reason why.
+// Anything reported by the harness that is not listed here fails the test,
+// and so does an entry here that is no longer reported.
+var openapiKnownDrift = map[string]string{
+	`EncryptionType: value "rsa" has no EncryptionType constant`:     "secret references are not supported, secrets are sent inline",
+	`EncryptionType: value "rsa_aes" has no EncryptionType constant`: "secret references are not supported, secrets are sent inline",
+	"GoogleDriveSourceConnectorConfigInput: failed to decode sample into *unstructured.GoogleDriveSourceConnectorConfig: " +
+		"json: cannot unmarshal object into Go struct field GoogleDriveSourceConnectorConfig.service_account_key of type string": "secret references are not supported, the service account key is sent inline",
+	"SourceConnectorInformation(google_drive): failed to decode sample into *unstructured.Source: failed to unmarshal google_drive config: " +
+		"json: cannot unmarshal object into Go struct field GoogleDriveSourceConnectorConfig.service_account_key of type string": "secret references are not supported, the service account key is sent inline",
+	"SourceConnectorInformation(box).config.remote_url: unknown field": "the API omits remote_url from Box configs it returns, but requires it on create",
+}
+
+// openapiOperations maps each operation in openapi.json to the client method that implements it.
+var openapiOperations = map[string]string{
+	"list_destinations":                    "ListDestinations",
+	"create_destination":                   "CreateDestination",
+	"get_destination":                      "GetDestination",
+	"update_destination":                   "UpdateDestination",
+	"delete_destination":                   "DeleteDestination",
+	"create_connection_check_destinations": "CreateDestinationConnectionCheck",
+	"get_connection_check_destinations":    "GetDestinationConnectionCheck",
+	"list_sources":                         "ListSources",
+	"create_source":                        "CreateSource",
+	"get_source":                           "GetSource",
+	"delete_source":                        "DeleteSource",
+	"update_source":                        "UpdateSource",
+	"create_connection_check_sources":      "CreateSourceConnectionCheck",
+	"get_connection_check_sources":         "GetSourceConnectionCheck",
+	"list_jobs":                            "ListJobs",
+	"get_job":                              "GetJob",
+	"cancel_job":                           "CancelJob",
+	"download_job_output":                  "DownloadJob",
+	"get_job_details":                      "GetJobDetails",
+	"get_job_failed_files":                 "GetJobFailedFiles",
+	"create_workflow":                      "CreateWorkflow",
+	"list_workflows":                       "ListWorkflows",
+	"get_workflow":                         "GetWorkflow",
+	"update_workflow":                      "UpdateWorkflow",
+	"delete_workflow":                      "DeleteWorkflow",
+	"run_workflow":                         "RunWorkflow",
+}
+
+// openapiEnums maps each enum schema to the Go type whose constants must cover it.
+// Connector types are untyped constants, so they are collected by their ConnectorType prefix.
+var openapiEnums = map[string]string{
+	"ConnectionCheckStatus":    "ConnectionCheckStatus",
+	"DestinationConnectorType": "ConnectorType",
+	"EncryptionType":           "EncryptionType",
+	"JobProcessingStatus":      "JobProcessingStatus",
+	"JobStatus":                "JobStatus",
+	"SortDirection":            "SortDirection",
+	"SourceConnectorType":      "ConnectorType",
+	"WorkflowJobType":          "WorkflowJobType",
+	"WorkflowState":            "WorkflowState",
+	"WorkflowType":             "WorkflowType",
+}
+
+// openapiTypes maps response schemas to the SDK types that decode them.
+var openapiTypes = map[string]func() any{
+	"DagNodeConnectionCheck": func() any { return new(DagNodeConnectionCheck) },
+	"FailedFile":             func() any { return new(FailedFile) },
+	"JobDetails":             func() any { return new(JobDetails) },
+	"JobFailedFiles":         func() any { return new(JobFailedFiles) },
+	"JobInformation":         func() any { return new(Job) },
+	"JobNodeDetails":         func() any { return new(JobNodeDetails) },
+	"NodeFileMetadata":       func() any { return new(NodeFileMetadata) },
+	"WorkflowInformation":    func() any { return new(Workflow) },
+	"WorkflowSchedule":       func() any { return new(WorkflowSchedule) },
+}
+
+// openapiSourceConfigs maps source connector types to their config schemas.
+var openapiSourceConfigs = map[string]string{
+	ConnectorTypeAzure:             "AzureSourceConnectorConfig",
+	ConnectorTypeBox:               "BoxSourceConnectorConfig",
+	ConnectorTypeConfluence:        "ConfluenceSourceConnectorConfig",
+	ConnectorTypeCouchbase:         "CouchbaseSourceConnectorConfig",
+	ConnectorTypeDatabricksVolumes: "DatabricksVolumesConnectorConfig",
+	ConnectorTypeDropbox:           "DropboxSourceConnectorConfig",
+	ConnectorTypeElasticsearch:     "ElasticsearchConnectorConfig",
+	ConnectorTypeGCS:               "GCSSourceConnectorConfig",
+	ConnectorTypeGoogleDrive:       "GoogleDriveSourceConnectorConfig",
+	ConnectorTypeJira:              "JiraSourceConnectorConfig",
+	ConnectorTypeKafkaCloud:        "KafkaCloudSourceConnectorConfig",
+	ConnectorTypeMongoDB:           "MongoDBConnectorConfig",
+	ConnectorTypeOneDrive:          "OneDriveSourceConnectorConfig",
+	ConnectorTypeOutlook:           "OutlookSourceConnectorConfig",
+	ConnectorTypePostgres:          "PostgresSourceConnectorConfig",
+	ConnectorTypeS3:                "S3SourceConnectorConfig",
+	ConnectorTypeSalesforce:        "SalesforceSourceConnectorConfig",
+	ConnectorTypeSharePoint:        "SharePointSourceConnectorConfig",
+	ConnectorTypeSnowflake:         "SnowflakeSourceConnectorConfig",
+	ConnectorTypeZendesk:           "ZendeskSourceConnectorConfig",
+}
+
+// openapiDestinationConfigs maps destination connector types to their config schemas.
+var openapiDestinationConfigs = map[string]string{
+	ConnectorTypeAstraDB:                    "AstraDBConnectorConfig",
+	ConnectorTypeAzureAISearch:              "AzureAISearchConnectorConfig",
+	ConnectorTypeCouchbase:                  "CouchbaseDestinationConnectorConfig",
+	ConnectorTypeDatabricksVolumes:          "DatabricksVolumesConnectorConfig",
+	ConnectorTypeDatabricksVolumeDeltaTable: "DatabricksVDTDestinationConnectorConfig",
+	ConnectorTypeDeltaTable:                 "DeltaTableConnectorConfig",
+	ConnectorTypeElasticsearch:              "ElasticsearchConnectorConfig",
+	ConnectorTypeGCS:                        "GCSDestinationConnectorConfig",
+	ConnectorTypeIBMWatsonxS3:               "IBMWatsonxS3DestinationConnectorConfig",
+	ConnectorTypeKafkaCloud:                 "KafkaCloudDestinationConnectorConfig",
+	ConnectorTypeMilvus:                     "MilvusDestinationConnectorConfig",
+	ConnectorTypeMongoDB:                    "MongoDBConnectorConfig",
+	ConnectorTypeNeo4j:                      "Neo4jDestinationConnectorConfig",
+	ConnectorTypeOneDrive:                   "OneDriveDestinationConnectorConfig",
+	ConnectorTypePinecone:                   "PineconeDestinationConnectorConfig",
+	ConnectorTypePostgres:                   "PostgresDestinationConnectorConfig",
+	ConnectorTypeQdrantCloud:                "QdrantCloudDestinationConnectorConfig",
+	ConnectorTypeRedis:                      "RedisDestinationConnectorConfig",
+	ConnectorTypeS3:                         "S3DestinationConnectorConfig",
+	ConnectorTypeSnowflake:                  "SnowflakeDestinationConnectorConfig",
+	ConnectorTypeWeaviateCloud:              "WeaviateDestinationConnectorConfig",
+}
+
+// openapiNodes is one of each workflow node type, checked against the WorkflowNode schema.
+var openapiNodes = []WorkflowNode{
+	&PartitionerAuto{Name: "Partitioner"},
+	&PartitionerVLM{Name: "Partitioner", Provider: ProviderAnthropic, Model: ModelClaude37Sonnet},
+	&PartitionerHiRes{Name: "Partitioner"},
+	&PartitionerFast{Name: "Partitioner"},
+	&ChunkerCharacter{Name: "Chunker", MaxCharacters: 500},
+	&ChunkerTitle{Name: "Chunker", MaxCharacters: 500},
+	&ChunkerPage{Name: "Chunker", MaxCharacters: 500},
+	&ChunkerSimilarity{Name: "Chunker", MaxCharacters: 500},
+	&Embedder{Name: "Embedder", Subtype: EmbedderSubtypeAzureOpenAI, ModelName: EmbedderModelAzureOpenAITextEmbedding3Small},
+	&Enricher{Name: "Enricher", Subtype: EnrichmentTypeImageOpenAI},
+}
+
+type openapiSpec struct {
+	Paths      map[string]map[string]openapiOperation `json:"paths"`
+	Components struct {
+		Schemas map[string]*openapiSchema `json:"schemas"`
+	} `json:"components"`
+}
+
+type openapiOperation struct {
+	OperationID string `json:"operationId"`
+}
+
+type openapiSchema struct {
+	Ref                  string                    `json:"$ref"`
+	Type                 string                    `json:"type"`
+	Format               string                    `json:"format"`
+	Enum                 []any                     `json:"enum"`
+	Properties           map[string]*openapiSchema `json:"properties"`
+	Required             []string                  `json:"required"`
+	Items                *openapiSchema            `json:"items"`
+	AnyOf                []*openapiSchema          `json:"anyOf"`
+	AdditionalProperties any                       `json:"additionalProperties"`
+}
+
+// conformance checks the SDK's types against the OpenAPI spec and collects the problems it finds.
+type conformance struct {
+	spec     openapiSpec
+	consts   map[string][]string
+	problems []string
+}
+
+func newConformance(t *testing.T) *conformance {
+	t.Helper()
+
+	data, err := os.ReadFile("openapi.json")
+	if err != nil {
+		t.Fatalf("failed to read openapi.json: %v", err)
+	}
+
+	h := &conformance{consts: goConstants(t)}
+	if err := json.Unmarshal(data, &h.spec); err != nil {
+		t.Fatalf("failed to parse openapi.json: %v", err)
+	}
+
+	return h
+}
+
+func (h *conformance) report(format string, args ...any) {
+	h.problems = append(h.problems, fmt.Sprintf(format, args...))
+}
+
+func (h *conformance) schema(name string) *openapiSchema {
+	return h.spec.Components.Schemas[name]
+}
+
+func (h *conformance) resolve(s *openapiSchema) *openapiSchema {
+	for s != nil && s.Ref != "" {
+		s = h.schema(strings.TrimPrefix(s.Ref, "#/components/schemas/"))
+	}
+
+	return s
+}
+
+// sample generates a payload for the schema in which every property is set to a non-zero value,
+// so that omitempty fields survive a round trip through the SDK's types.
+func (h *conformance) sample(s *openapiSchema, depth int) any {
+	if s.Ref == "#/components/schemas/WorkflowNode" {
+		return map[string]any{
+			"id":       "00000000-0000-4000-8000-000000000001",
+			"name":     "Partitioner",
+			"type":     nodeTypePartition,
+			"subtype":  string(PartitionerStrategyFast),
+			"settings": map[string]any{},
+		}
+	}
+
+	s = h.resolve(s)
+	if s == nil || depth > 8 {
+		return nil
+	}
+
+	if len(s.Enum) > 0 {
+		return s.Enum[0]
+	}
+
+	for _, alt := range s.AnyOf {
+		if h.resolve(alt).Type != "null" {
+			return h.sample(alt, depth+1)
+		}
+	}
+
+	switch s.Type {
+	case "string":
+		switch s.Format {
+		case "uuid":
+			return "00000000-0000-4000-8000-000000000001"
+		case "date-time":
+			return "2025-01-01T00:00:00Z"
+		case "duration":
+			return "PT1M30S"
+		}
+
+		return "sample"
+
+	case "integer":
+		return float64(1)
+
+	case "number":
+		return 1.5
+
+	case "boolean":
+		return true
+
+	case "array":
+		return []any{h.sample(s.Items, depth+1)}
+
+	case "object":
+		obj := make(map[string]any, len(s.Properties))
+		for name, prop := range s.Properties {
+			obj[name] = h.sample(prop, depth+1)
+		}
+
+		return obj
+	}
+
+	return nil
+}
+
+// roundTrip decodes a sample of schema s into v, re-encodes v and compares the result to the schema.
+func (h *conformance) roundTrip(name string, s *openapiSchema, sample any, v any) {
+	data, err := json.Marshal(sample)
+	if err != nil {
+		h.report("%s: failed to marshal sample: %v", name, err)
+		return
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		h.report("%s: failed to decode sample into %T: %v", name, v, err)
+		return
+	}
+
+	if data, err = json.Marshal(v); err != nil {
+		h.report("%s: failed to encode %T: %v", name, v, err)
+		return
+	}
+
+	var got any
+	if err := json.Unmarshal(data, &got); err != nil {
+		h.report("%s: failed to decode %T output: %v", name, v, err)
+		return
+	}
+
+	h.compare(name, s, sample, got)
+}
+
+// compare walks the schema and reports fields of want that are missing from got,
+// fields of got the schema does not know about, and values of got with the wrong JSON type.
+func (h *conformance) compare(path string, s *openapiSchema, want, got any) {
+	s = h.resolve(s)
+	if s == nil || got == nil {
+		return
+	}
+
+	if len(s.AnyOf) > 0 {
+		for _, alt := range s.AnyOf {
+			if r := h.resolve(alt); r.Type == jsonType(got) || (r.Type == "number" && jsonType(got) == "integer") {
+				h.compare(path, alt, want, got)
+				return
+			}
+		}
+
+		h.report("%s: wrong type %s", path, jsonType(got))
+
+		return
+	}
+
+	if s.Type == "" {
+		return
+	}
+
+	if typ := jsonType(got); typ != s.Type && (s.Type != "number" || typ != "integer") {
+		h.report("%s: wrong type %s, want %s", path, typ, s.Type)
+		return
+	}
+
+	if len(s.Enum) > 0 && !slices.Contains(s.Enum, got) {
+		h.report("%s: value %v is not in enum %v", path, got, s.Enum)
+	}
+
+	switch got := got.(type) {
+	case map[string]any:
+		w, _ := want.(map[string]any)
+
+		for name, prop := range s.Properties {
+			v, ok := got[name]
+			if !ok && w[name] != nil {
+				h.report("%s.%s: missing field", path, name)
+				continue
+			}
+
+			h.compare(path+"."+name, prop, w[name], v)
+		}
+
+		if len(s.Properties) == 0 || s.AdditionalProperties == true {
+			return
+		}
+
+		for name := range got {
+			if _, ok := s.Properties[name]; !ok {
+				h.report("%s.%s: unknown field", path, name)
+			}
+		}
+
+	case []any:
+		w, _ := want.([]any)
+
+		for i, v := range got {
+			var item any
+			if i < len(w) {
+				item = w[i]
+			}
+
+			h.compare(path+"["+strconv.Itoa(i)+"]", s.Items, item, v)
+		}
+	}
+}
+
+func (h *conformance) checkOperations() {
+	client := reflect.TypeOf(&Client{})
+
+	for path, ops := range h.spec.Paths {
+		for method, op := range ops {
+			name, ok := openapiOperations[op.OperationID]
+			if !ok {
+				h.report("%s %s (%s): no client method", strings.ToUpper(method), path, op.OperationID)
+				continue
+			}
+
+			if _, ok := client.MethodByName(name); !ok {
+				h.report("%s %s (%s): client method %s does not exist", strings.ToUpper(method), path, op.OperationID, name)
+			}
+		}
+	}
+}
+
+func (h *conformance) checkEnums() {
+	for name, goType := range openapiEnums {
+		s := h.schema(name)
+		if s == nil {
+			h.report("%s: enum schema does not exist", name)
+			continue
+		}
+
+		for _, v := range s.Enum {
+			if !slices.Contains(h.consts[goType], fmt.Sprint(v)) {
+				h.report("%s: value %q has no %s constant", name, v, goType)
+			}
+		}
+	}
+}
+
+func (h *conformance) checkTypes() {
+	for name, factory := range openapiTypes {
+		h.roundTrip(name, h.schema(name), h.sample(h.schema(name), 0), factory())
+	}
+}
+
+func (h *conformance) checkConnectors() {
+	type connector struct {
+		info    string
+		configs map[string]string
+		factory func(typ string) any
+	}
+
+	for _, c := range []connector{
+		{
+			info:    "SourceConnectorInformation",
+			configs: openapiSourceConfigs,
+			factory: func(typ string) any {
+				if f, ok := sourceConfigFactories[typ]; ok {
+					return f()
+				}
+
+				return nil
+			},
+		},
+		{
+			info:    "DestinationConnectorInformation",
+			configs: openapiDestinationConfigs,
+			factory: func(typ string) any {
+				if f, ok := destinationConfigFactories[typ]; ok {
+					return f()
+				}
+
+				return nil
+			},
+		},
+	} {
+		for _, alt := range h.schema(c.info).Properties["config"].AnyOf {
+			if alt.Ref == "" {
+				continue
+			}
+
+			name := strings.TrimPrefix(alt.Ref, "#/components/schemas/")
+			if !slices.ContainsFunc(mapValues(c.configs), func(s string) bool { return s == name }) {
+				h.report("%s: config schema %s is not mapped to a connector type", c.info, name)
+			}
+		}
+
+		for typ, name := range c.configs {
+			if c.factory(typ) == nil {
+				h.report("%s: connector type %q has no config factory", c.info, typ)
+				continue
+			}
+
+			// narrow the config to this connector's schema so the sample and comparison use it.
+			schema := *h.schema(c.info)
+			schema.Properties = maps.Clone(schema.Properties)
+			schema.Properties["config"] = &openapiSchema{Ref: "#/components/schemas/" + name}
+
+			info, _ := h.sample(&schema, 0).(map[string]any)
+			info["type"] = typ
+
+			var v any = new(Source)
+			if c.info == "DestinationConnectorInformation" {
+				v = new(Destination)
+			}
+
+			h.roundTrip(c.info+"("+typ+")", &schema, info, v)
+
+			// configs are also sent on create and update, so they must cover the input schemas too.
+			if input := h.schema(name + "Input"); input != nil {
+				h.roundTrip(name+"Input", input, h.sample(input, 0), c.factory(typ))
+			}
+		}
+	}
+}
+
+func (h *conformance) checkNodes() {
+	s := h.schema("WorkflowNode")
+
+	for _, node := range openapiNodes {
+		name := fmt.Sprintf("WorkflowNode(%T)", node)
+
+		data, err := json.Marshal(node)
+		if err != nil {
+			h.report("%s: failed to encode: %v", name, err)
+			continue
+		}
+
+		var got map[string]any
+		if err := json.Unmarshal(data, &got); err != nil {
+			h.report("%s: failed to decode output: %v", name, err)
+			continue
+		}
+
+		for _, field := range s.Required {
+			if _, ok := got[field]; !ok {
+				h.report("%s.%s: missing required field", name, field)
+			}
+		}
+
+		h.compare(name, s, got, got)
+
+		decoded, err := unmarshalNode(data)
+		if err != nil {
+			h.report("%s: failed to decode own output: %v", name, err)
+			continue
+		}
+
+		again, err := json.Marshal(decoded)
+		if err != nil {
+			h.report("%s: failed to re-encode: %v", name, err)
+			continue
+		}
+
+		if string(again) != string(data) {
+			h.report("%s: round trip changed the node: %s != %s", name, again, data)
+		}
+	}
+}
+
+func mapValues(m map[string]string) []string {
+	out := make([]string, 0, len(m))
+	for _, v := range m {
+		out = append(out, v)
+	}
+
+	return out
+}
+
+func jsonType(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == float64(int64(v)) {
+			return "integer"
+		}
+
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+
+	return fmt.Sprintf("%T", v)
+}
+
+// goConstants parses the package source and returns the string constants declared for each type.
+// Untyped constants are grouped by their ConnectorType prefix.
+func goConstants(t *testing.T) map[string][]string {
+	t.Helper()
+
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatalf("failed to parse package: %v", err)
+	}
+
+	consts := make(map[string][]string)
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.CONST {
+					continue
+				}
+
+				for _, spec := range gen.Specs {
+					vs, _ := spec.(*ast.ValueSpec)
+
+					for i, name := range vs.Names {
+						if i >= len(vs.Values) {
+							continue
+						}
+
+						lit, ok := vs.Values[i].(*ast.BasicLit)
+						if !ok || lit.Kind != token.STRING {
+							continue
+						}
+
+						val, _ := strconv.Unquote(lit.Value)
+
+						switch typ := vs.Type.(type) {
+						case *ast.Ident:
+							consts[typ.Name] = append(consts[typ.Name], val)
+						case nil:
+							if strings.HasPrefix(name.Name, "ConnectorType") {
+								consts["ConnectorType"] = append(consts["ConnectorType"], val)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return consts
+}
+
+func TestOpenAPIConformance(t *testing.T) {
+	t.Parallel()
+
+	h := newConformance(t)
+	h.checkOperations()
+	h.checkEnums()
+	h.checkTypes()
+	h.checkConnectors()
+	h.checkNodes()
+
+	slices.Sort(h.problems)
+	h.problems = slices.Compact(h.problems)
+
+	for _, problem := range h.problems {
+		if _, ok := openapiKnownDrift[problem]; !ok {
+			t.Errorf("openapi drift: %s", problem)
+		}
+	}
+
+	for problem := range openapiKnownDrift {
+		if !slices.Contains(h.problems, problem) {
+			t.Errorf("known openapi drift is no longer reported, remove it: %s", problem)
+		}
+	}
+}
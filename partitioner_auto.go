@@ -44,7 +44,7 @@ func (p PartitionerAuto) MarshalJSON() ([]byte, error) {
 		ID:       p.ID,
 		Name:     p.Name,
 		Type:     nodeTypePartition,
-		Subtype:  PartitionerStrategyVLM,
+		Subtype:  PartitionerStrategyAuto,
 		Settings: json.RawMessage(data),
 	})
 	if err != nil {
@@ -55,3 +55,8 @@ func (p PartitionerAuto) MarshalJSON() ([]byte, error) {
 }
 
 func (p *PartitionerAuto) isNode() {}
+
+// ValidateModel validates that the provider offers the model, and that the model supports vision.
+func (p *PartitionerAuto) ValidateModel() error {
+	return validateVisionModel(p.Provider, p.Model)
+}
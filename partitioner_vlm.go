@@ -37,8 +37,8 @@ func (p PartitionerVLM) MarshalJSON() ([]byte, error) {
 	headerData, err := json.Marshal(header{
 		ID:       p.ID,
 		Name:     p.Name,
-		Type:     PartitionerStrategyVLM,
-		Subtype:  string(nodeTypePartition),
+		Type:     nodeTypePartition,
+		Subtype:  PartitionerStrategyVLM,
 		Settings: json.RawMessage(data),
 	})
 	if err != nil {
@@ -49,3 +49,8 @@ func (p PartitionerVLM) MarshalJSON() ([]byte, error) {
 }
 
 func (p *PartitionerVLM) isNode() {}
+
+// ValidateModel validates that the provider offers the model, and that the model supports vision.
+func (p *PartitionerVLM) ValidateModel() error {
+	return validateVisionModel(p.Provider, p.Model)
+}
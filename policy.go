@@ -0,0 +1,269 @@
+package unstructured
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryPolicy controls how requests that fail with a transient error are retried.
+//
+// Requests answered with 429 Too Many Requests or 503 Service Unavailable are retried whatever their method,
+// since the API did not act on them. Requests that fail with a network error, 502 Bad Gateway or
+// 504 Gateway Timeout are only retried if their method is idempotent, like GET, PUT and DELETE,
+// so that a resource is never created twice.
+type RetryPolicy struct {
+	// MaxAttempts is how many times a request is sent at most, including the first attempt.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry, which doubles with each further retry.
+	// It defaults to 500ms.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts, including delays asked for by a Retry-After header.
+	// It defaults to 30s.
+	MaxBackoff time.Duration
+}
+
+func (p RetryPolicy) validate() error {
+	if p.MaxAttempts < 0 || p.MinBackoff < 0 || p.MaxBackoff < 0 {
+		return fmt.Errorf("retry policy must not be negative, got %+v", p)
+	}
+
+	if p.MaxBackoff > 0 && p.MinBackoff > p.MaxBackoff {
+		return fmt.Errorf("minimum backoff %s is longer than maximum backoff %s", p.MinBackoff, p.MaxBackoff)
+	}
+
+	return nil
+}
+
+// backoff returns how long to wait before the given retry, counting from 1.
+// The delay is jittered between half and all of the exponential backoff, so that clients do not retry in lockstep.
+func (p RetryPolicy) backoff(retry int, resp *http.Response) time.Duration {
+	maxBackoff := cmp.Or(p.MaxBackoff, 30*time.Second)
+
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(d, maxBackoff)
+		}
+	}
+
+	d := cmp.Or(p.MinBackoff, 500*time.Millisecond)
+	for i := 1; i < retry && d < maxBackoff; i++ {
+		d *= 2
+	}
+
+	d = min(d, maxBackoff)
+
+	return d/2 + rand.N(d/2+1) //nolint:gosec
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
+
+// RateLimit limits how many requests a client sends.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate of requests. Zero means no limit.
+	RequestsPerSecond float64
+
+	// Burst is how many requests may be sent at once before the rate applies.
+	// It defaults to 1.
+	Burst int
+}
+
+func (l RateLimit) validate() error {
+	if l.RequestsPerSecond < 0 || l.Burst < 0 {
+		return fmt.Errorf("rate limit must not be negative, got %+v", l)
+	}
+
+	return nil
+}
+
+// policy is a [http.RoundTripper] that applies the timeout, retry and rate limit settings of a client.
+type policy struct {
+	rt      http.RoundTripper
+	timeout time.Duration
+	retry   RetryPolicy
+	limiter *limiter
+}
+
+func newPolicy(rt http.RoundTripper, timeout time.Duration, retry RetryPolicy, limit RateLimit) *policy {
+	p := policy{rt: rt, timeout: timeout, retry: retry}
+
+	if limit.RequestsPerSecond > 0 {
+		burst := float64(cmp.Or(limit.Burst, 1))
+
+		p.limiter = &limiter{
+			rate:   limit.RequestsPerSecond,
+			burst:  burst,
+			tokens: burst,
+			last:   time.Now(),
+		}
+	}
+
+	return &p
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (p *policy) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		if p.limiter != nil {
+			if err := p.limiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		r := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+
+			r = req.Clone(ctx)
+			r.Body = body
+		}
+
+		resp, err := p.send(r)
+
+		if attempt >= p.retry.MaxAttempts || !retryable(req, resp, err) || ctx.Err() != nil {
+			return resp, err
+		}
+
+		delay := p.retry.backoff(attempt, resp)
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err() //nolint:wrapcheck
+		case <-timer.C:
+		}
+	}
+}
+
+// send makes a single attempt at the request, limited by the timeout if there is one.
+func (p *policy) send(req *http.Request) (*http.Response, error) {
+	if p.timeout <= 0 {
+		return p.rt.RoundTrip(req) //nolint:wrapcheck
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), p.timeout)
+
+	resp, err := p.rt.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err //nolint:wrapcheck
+	}
+
+	// the timeout also covers reading the body, so it may only be released once the body is closed.
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close() //nolint:wrapcheck
+}
+
+// retryable reports whether a request that got resp or err may be sent again.
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions ||
+		req.Method == http.MethodPut || req.Method == http.MethodDelete
+
+	if err != nil {
+		return idempotent && !errors.Is(err, context.Canceled)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	}
+
+	return false
+}
+
+// limiter is a token bucket that refills at rate tokens per second, up to burst tokens.
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// wait takes a token, waiting for one to become available if there are none.
+func (l *limiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	// take the token now, even if it is only available later, so that waiting requests queue up in order.
+	l.tokens--
+
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+
+		return fmt.Errorf("failed to wait for rate limit: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+package unstructured
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	client, mux := testclient(t, WithRetry(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}))
+
+	var gets, creates atomic.Int32
+
+	mux.GetSource = func(w http.ResponseWriter, _ *http.Request) {
+		if gets.Add(1) < 3 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "1", "name": "docs", "type": "s3", "config": {"remote_url": "s3://docs/"}}`))
+	}
+
+	mux.CreateSource = func(w http.ResponseWriter, _ *http.Request) {
+		creates.Add(1)
+		http.Error(w, "bad gateway", http.StatusBadGateway)
+	}
+
+	if _, err := client.GetSource(testContext(t), "1"); err != nil {
+		t.Errorf("expected source to be returned after retries, got %v", err)
+	}
+
+	_, err := client.CreateSource(testContext(t), CreateSourceRequest{
+		Name:   "docs",
+		Config: &S3ConnectorConfig{RemoteURL: "s3://docs/"},
+	})
+
+	var apierr *APIError
+	if !errors.As(err, &apierr) || apierr.Code != http.StatusBadGateway {
+		t.Errorf("expected a 502 API error, got %v", err)
+	}
+
+	if err := errors.Join(
+		eq("get attempts", gets.Load(), 3),
+		eq("create attempts", creates.Load(), 1),
+	); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	p := RetryPolicy{MaxAttempts: 5, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for retry, want := range map[int]time.Duration{1: 100 * time.Millisecond, 3: 400 * time.Millisecond, 10: time.Second} {
+		if got := p.backoff(retry, nil); got < want/2 || got > want {
+			t.Errorf("expected backoff before retry %d to be between %s and %s, got %s", retry, want/2, want, got)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	if got := p.backoff(1, resp); got != time.Second {
+		t.Errorf("expected Retry-After to be capped at %s, got %s", time.Second, got)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	client, mux := testclient(t, WithTimeout(10*time.Millisecond))
+
+	mux.GetJob = func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}
+
+	if _, err := client.GetJob(testContext(t), "1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected request to time out, got %v", err)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	l := newPolicy(http.DefaultTransport, 0, RetryPolicy{}, RateLimit{RequestsPerSecond: 100, Burst: 2}).limiter
+
+	start := time.Now()
+
+	for range 5 {
+		if err := l.wait(testContext(t)); err != nil {
+			t.Fatalf("failed to wait for rate limit: %v", err)
+		}
+	}
+
+	// the burst is free, the three requests after it wait 10ms each.
+	if elapsed := time.Since(start); elapsed < 25*time.Millisecond {
+		t.Errorf("expected 5 requests at 100/s with a burst of 2 to take at least 25ms, took %s", elapsed)
+	}
+
+	slow := newPolicy(http.DefaultTransport, 0, RetryPolicy{}, RateLimit{RequestsPerSecond: 0.1}).limiter
+	if err := slow.wait(testContext(t)); err != nil {
+		t.Fatalf("failed to wait for rate limit: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(testContext(t))
+	cancel()
+
+	if err := slow.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected waiting with a canceled context to fail, got %v", err)
+	}
+}
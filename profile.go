@@ -0,0 +1,538 @@
+package unstructured
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config is the contents of a config file, which holds named profiles.
+type Config struct {
+	Profiles map[string]Profile
+}
+
+// Profile is a named set of client settings, such as the endpoint and credentials of one Unstructured account.
+// At most one of Key, KeyCommand and KeyRef may be set.
+type Profile struct {
+	// Endpoint is the base URL of the API, like [WithEndpoint].
+	Endpoint string
+
+	// Key is the API key.
+	Key string
+
+	// KeyCommand is a shell command that prints the API key, such as a password manager lookup.
+	// It is only run if no API key is given as an option, or as an environment variable
+	// when the profile is selected with `UNSTRUCTURED_PROFILE`, and fails if it runs for more than 30 seconds.
+	KeyCommand string
+
+	// KeyRef is a reference to a secret that holds the API key:
+	// "env:NAME" reads the environment variable NAME, and "file:PATH" reads the file at PATH.
+	KeyRef string
+
+	// Timeout limits each attempt at a request, like [WithTimeout].
+	Timeout time.Duration
+
+	// Retry controls retries, like [WithRetry].
+	Retry RetryPolicy
+
+	// RateLimit limits the request rate, like [WithRateLimit].
+	RateLimit RateLimit
+}
+
+// DefaultConfigPath returns the path of the config file used when neither [WithConfigFile]
+// nor the `UNSTRUCTURED_CONFIG` environment variable name one:
+// `$XDG_CONFIG_HOME/unstructured/config.yaml`, which defaults to `~/.config/unstructured/config.yaml`.
+func DefaultConfigPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to find config directory: %w", err)
+		}
+
+		dir = filepath.Join(home, ".config")
+	}
+
+	return filepath.Join(dir, "unstructured", "config.yaml"), nil
+}
+
+// LoadConfig reads the config file at path.
+// If path is empty, it reads the file named by the `UNSTRUCTURED_CONFIG` environment variable
+// or, failing that, the one at [DefaultConfigPath].
+//
+// The config file is written in a subset of YAML made of nested mappings and scalars:
+//
+//	profiles:
+//	  staging:
+//	    endpoint: https://staging.example.com/api/v1
+//	    key_command: op read op://dev/unstructured/api-key
+//	    timeout: 30s
+//	    retry:
+//	      max_attempts: 3
+//	      min_backoff: 500ms
+//	      max_backoff: 10s
+//	    rate_limit:
+//	      requests_per_second: 5
+//	      burst: 10
+//	  production:
+//	    key_ref: env:UNSTRUCTURED_PRODUCTION_KEY
+func LoadConfig(path string) (*Config, error) {
+	if path == "" {
+		path = os.Getenv("UNSTRUCTURED_CONFIG")
+	}
+
+	if path == "" {
+		var err error
+		if path, err = DefaultConfigPath(); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open config file: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	cfg, err := ParseConfig(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// ParseConfig parses a config file in the format described by [LoadConfig].
+func ParseConfig(r io.Reader) (*Config, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	doc, err := parseYAMLMapping(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := Config{Profiles: make(map[string]Profile)}
+
+	for key, value := range doc {
+		if key != "profiles" {
+			return nil, fmt.Errorf("unknown config key %q", key)
+		}
+
+		profiles, ok := value.(map[string]any)
+		if !ok {
+			return nil, errors.New("profiles must be a mapping")
+		}
+
+		for name, value := range profiles {
+			p, err := parseProfile(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid profile %s: %w", name, err)
+			}
+
+			cfg.Profiles[name] = p
+		}
+	}
+
+	return &cfg, nil
+}
+
+func parseProfile(v any) (Profile, error) {
+	var p Profile
+
+	fields, ok := v.(map[string]any)
+	if !ok {
+		return p, errors.New("profile must be a mapping")
+	}
+
+	var errs []error
+
+	for key, value := range fields {
+		var err error
+
+		switch key {
+		case "endpoint":
+			p.Endpoint, err = yamlString(value)
+		case "key":
+			p.Key, err = yamlString(value)
+		case "key_command":
+			p.KeyCommand, err = yamlString(value)
+		case "key_ref":
+			p.KeyRef, err = yamlString(value)
+		case "timeout":
+			p.Timeout, err = yamlDuration(value)
+		case "retry":
+			p.Retry, err = parseRetryPolicy(value)
+		case "rate_limit":
+			p.RateLimit, err = parseRateLimit(value)
+		default:
+			err = errors.New("unknown key")
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+
+	keys := 0
+
+	for _, k := range []string{p.Key, p.KeyCommand, p.KeyRef} {
+		if k != "" {
+			keys++
+		}
+	}
+
+	if keys > 1 {
+		errs = append(errs, errors.New("only one of key, key_command and key_ref may be set"))
+	}
+
+	errs = append(errs, p.Retry.validate(), p.RateLimit.validate())
+
+	return p, errors.Join(errs...)
+}
+
+func parseRetryPolicy(v any) (RetryPolicy, error) {
+	var p RetryPolicy
+
+	fields, ok := v.(map[string]any)
+	if !ok {
+		return p, errors.New("must be a mapping")
+	}
+
+	for key, value := range fields {
+		var err error
+
+		switch key {
+		case "max_attempts":
+			p.MaxAttempts, err = yamlInt(value)
+		case "min_backoff":
+			p.MinBackoff, err = yamlDuration(value)
+		case "max_backoff":
+			p.MaxBackoff, err = yamlDuration(value)
+		default:
+			err = errors.New("unknown key")
+		}
+
+		if err != nil {
+			return p, fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return p, nil
+}
+
+func parseRateLimit(v any) (RateLimit, error) {
+	var l RateLimit
+
+	fields, ok := v.(map[string]any)
+	if !ok {
+		return l, errors.New("must be a mapping")
+	}
+
+	for key, value := range fields {
+		var err error
+
+		switch key {
+		case "requests_per_second":
+			var s string
+			if s, err = yamlString(value); err == nil {
+				l.RequestsPerSecond, err = strconv.ParseFloat(s, 64)
+			}
+		case "burst":
+			l.Burst, err = yamlInt(value)
+		default:
+			err = errors.New("unknown key")
+		}
+
+		if err != nil {
+			return l, fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return l, nil
+}
+
+// keyCommandTimeout bounds how long the key command of a profile may run, so that a hanging credential helper
+// does not block [New] forever.
+var keyCommandTimeout = 30 * time.Second
+
+// apiKey returns the API key of the profile, running its key command or reading its key reference if needed.
+func (p Profile) apiKey() (string, error) {
+	switch {
+	case p.KeyCommand != "":
+		shell := []string{"sh", "-c"}
+		if runtime.GOOS == "windows" {
+			shell = []string{"cmd", "/C"}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), keyCommandTimeout)
+		defer cancel()
+
+		var stderr bytes.Buffer
+
+		cmd := exec.CommandContext(ctx, shell[0], shell[1], p.KeyCommand) //nolint:gosec
+		cmd.Stderr = &stderr
+		// the shell may leave children behind that keep its output open.
+		cmd.WaitDelay = time.Second
+
+		out, err := cmd.Output()
+		if ctx.Err() != nil {
+			return "", fmt.Errorf("key command did not finish within %s", keyCommandTimeout)
+		}
+
+		if err != nil {
+			return "", fmt.Errorf("failed to run key command: %w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+
+		return strings.TrimSpace(string(out)), nil
+
+	case p.KeyRef != "":
+		scheme, ref, _ := strings.Cut(p.KeyRef, ":")
+
+		switch scheme {
+		case "env":
+			v := os.Getenv(ref)
+			if v == "" {
+				return "", fmt.Errorf("environment variable %s referenced by key_ref is not set", ref)
+			}
+
+			return v, nil
+
+		case "file":
+			data, err := os.ReadFile(ref)
+			if err != nil {
+				return "", fmt.Errorf("failed to read key file: %w", err)
+			}
+
+			return strings.TrimSpace(string(data)), nil
+		}
+
+		return "", fmt.Errorf("unsupported key_ref %q, expected env:NAME or file:PATH", p.KeyRef)
+	}
+
+	return p.Key, nil
+}
+
+// applyProfile fills in the settings that are not set yet from the named profile.
+func (c *Client) applyProfile(name string) error {
+	cfg, err := LoadConfig(c.configPath)
+	if err != nil {
+		return err
+	}
+
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		names := make([]string, 0, len(cfg.Profiles))
+		for n := range cfg.Profiles {
+			names = append(names, n)
+		}
+
+		slices.Sort(names)
+
+		return fmt.Errorf("profile %q does not exist, expected one of: %s", name, strings.Join(names, ", "))
+	}
+
+	if c.endpoint == nil && p.Endpoint != "" {
+		u, err := url.Parse(p.Endpoint)
+		if err != nil {
+			return fmt.Errorf("failed to parse endpoint URL of profile %s: %w", name, err)
+		}
+
+		c.endpoint = u
+	}
+
+	if c.key == "" {
+		if c.key, err = p.apiKey(); err != nil {
+			return fmt.Errorf("failed to get API key of profile %s: %w", name, err)
+		}
+	}
+
+	c.timeout = cmp.Or(c.timeout, p.Timeout)
+	c.retry = cmp.Or(c.retry, p.Retry)
+	c.rateLimit = cmp.Or(c.rateLimit, p.RateLimit)
+
+	return nil
+}
+
+func yamlString(v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", errors.New("must be a scalar")
+	}
+
+	return s, nil
+}
+
+func yamlInt(v any) (int, error) {
+	s, err := yamlString(v)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer %q", s)
+	}
+
+	return n, nil
+}
+
+func yamlDuration(v any) (time.Duration, error) {
+	s, err := yamlString(v)
+	if err != nil {
+		return 0, err
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	return d, nil
+}
+
+type yamlLine struct {
+	num    int
+	indent int
+	key    string
+	value  string
+}
+
+// parseYAMLMapping parses the subset of YAML used by config files: nested mappings
+// of plain, single-quoted or double-quoted scalars, and comments.
+// Scalars are returned as strings and mappings as map[string]any.
+func parseYAMLMapping(data []byte) (map[string]any, error) {
+	var lines []yamlLine
+
+	for i, text := range strings.Split(string(data), "\n") {
+		text = strings.TrimRight(stripYAMLComment(text), " \t\r")
+		if strings.TrimSpace(text) == "" || text == "---" {
+			continue
+		}
+
+		content := strings.TrimLeft(text, " ")
+		if strings.HasPrefix(content, "\t") {
+			return nil, fmt.Errorf("line %d: tabs are not allowed for indentation", i+1)
+		}
+
+		key, value, ok := strings.Cut(content, ":")
+		if !ok || (value != "" && value[0] != ' ') {
+			return nil, fmt.Errorf("line %d: expected \"key: value\"", i+1)
+		}
+
+		key, err := yamlScalar(strings.TrimSpace(key))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		value, err = yamlScalar(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		lines = append(lines, yamlLine{num: i + 1, indent: len(text) - len(content), key: key, value: value})
+	}
+
+	if len(lines) == 0 {
+		return map[string]any{}, nil
+	}
+
+	m, rest, err := parseYAMLBlock(lines, lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("line %d: unexpected indentation", rest[0].num)
+	}
+
+	return m, nil
+}
+
+func parseYAMLBlock(lines []yamlLine, indent int) (map[string]any, []yamlLine, error) {
+	m := make(map[string]any)
+
+	for len(lines) > 0 && lines[0].indent >= indent {
+		l := lines[0]
+		if l.indent > indent {
+			return nil, nil, fmt.Errorf("line %d: unexpected indentation", l.num)
+		}
+
+		lines = lines[1:]
+
+		if _, dup := m[l.key]; dup {
+			return nil, nil, fmt.Errorf("line %d: duplicate key %q", l.num, l.key)
+		}
+
+		if l.value != "" || len(lines) == 0 || lines[0].indent <= indent {
+			m[l.key] = l.value
+			continue
+		}
+
+		child, rest, err := parseYAMLBlock(lines, lines[0].indent)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		m[l.key] = child
+		lines = rest
+	}
+
+	return m, lines, nil
+}
+
+// stripYAMLComment removes a comment, which starts with a # at the start of the line or after a space,
+// and is not inside a quoted scalar.
+func stripYAMLComment(s string) string {
+	var quote byte
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return s[:i]
+		}
+	}
+
+	return s
+}
+
+func yamlScalar(s string) (string, error) {
+	switch {
+	case len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"':
+		v, err := strconv.Unquote(s)
+		if err != nil {
+			return "", fmt.Errorf("invalid quoted string %s", s)
+		}
+
+		return v, nil
+
+	case len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'':
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+
+	case s == "~" || s == "null":
+		return "", nil
+	}
+
+	return s, nil
+}
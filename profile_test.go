@@ -0,0 +1,203 @@
+package unstructured
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go/test"
+)
+
+func TestParseConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := ParseConfig(strings.NewReader(`
+# accounts we switch between
+profiles:
+  staging:
+    endpoint: https://staging.example.com/api/v1  # not the default
+    key_command: "op read 'op://dev/unstructured/api-key'"
+    timeout: 30s
+    retry:
+      max_attempts: 3
+      min_backoff: 500ms
+      max_backoff: 10s
+    rate_limit:
+      requests_per_second: 2.5
+      burst: 10
+
+  production:
+    key_ref: 'env:UNSTRUCTURED_PRODUCTION_KEY'
+`))
+	if err != nil {
+		t.Fatalf("failed to parse config: %v", err)
+	}
+
+	staging, production := cfg.Profiles["staging"], cfg.Profiles["production"]
+
+	if err := errors.Join(
+		eq("len(profiles)", len(cfg.Profiles), 2),
+		eq("staging.endpoint", staging.Endpoint, "https://staging.example.com/api/v1"),
+		eq("staging.key_command", staging.KeyCommand, "op read 'op://dev/unstructured/api-key'"),
+		eq("staging.timeout", staging.Timeout, 30*time.Second),
+		eq("staging.retry", staging.Retry, RetryPolicy{MaxAttempts: 3, MinBackoff: 500 * time.Millisecond, MaxBackoff: 10 * time.Second}),
+		eq("staging.rate_limit", staging.RateLimit, RateLimit{RequestsPerSecond: 2.5, Burst: 10}),
+		eq("production", production, Profile{KeyRef: "env:UNSTRUCTURED_PRODUCTION_KEY"}),
+	); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParseConfigErrors(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		config string
+		want   string
+	}{
+		"unknown key":      {"profiles:\n  a:\n    endpont: x\n", "endpont: unknown key"},
+		"unknown section":  {"profile:\n  a:\n    key: x\n", `unknown config key "profile"`},
+		"two keys":         {"profiles:\n  a:\n    key: x\n    key_ref: env:X\n", "only one of key"},
+		"bad duration":     {"profiles:\n  a:\n    timeout: 30\n", `invalid duration "30"`},
+		"bad indentation":  {"profiles:\n    a:\n      key: x\n  b:\n    key: y\n", "line 4: unexpected indentation"},
+		"duplicate key":    {"profiles:\n  a:\n    key: x\n    key: y\n", `line 4: duplicate key "key"`},
+		"not a mapping":    {"profiles:\n  a:\n    retry: 3\n", "retry: must be a mapping"},
+		"negative backoff": {"profiles:\n  a:\n    retry:\n      min_backoff: -1s\n", "must not be negative"},
+		"missing colon":    {"profiles:\n  a\n", `line 2: expected "key: value"`},
+	} {
+		if _, err := ParseConfig(strings.NewReader(tc.config)); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: expected error containing %q, got %v", name, tc.want, err)
+		}
+	}
+}
+
+func TestWithProfile(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(test.NewFakeServer())
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+
+	keyFile := filepath.Join(dir, "key")
+	if err := os.WriteFile(keyFile, []byte(test.FakeAPIKey+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(config, []byte(`profiles:
+  file:
+    endpoint: `+server.URL+`/api/v1
+    key_ref: file:`+keyFile+`
+  command:
+    endpoint: `+server.URL+`/api/v1
+    key_command: echo `+test.FakeAPIKey+`
+  wrong:
+    endpoint: `+server.URL+`/api/v1
+    key: wrong
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	profiles := []string{"file", "wrong"}
+	if runtime.GOOS != "windows" {
+		profiles = append(profiles, "command")
+	}
+
+	for _, profile := range profiles {
+		client, err := New(WithConfigFile(config), WithProfile(profile))
+		if err != nil {
+			t.Fatalf("failed to create client for profile %s: %v", profile, err)
+		}
+
+		_, err = client.ListSources(testContext(t), "")
+
+		var apierr *APIError
+
+		switch {
+		case profile == "wrong" && (!errors.As(err, &apierr) || apierr.Code != http.StatusUnauthorized):
+			t.Errorf("expected profile %s to be rejected, got %v", profile, err)
+		case profile != "wrong" && err != nil:
+			t.Errorf("failed to list sources with profile %s: %v", profile, err)
+		}
+	}
+
+	// explicit options take precedence over the profile.
+	client, err := New(WithConfigFile(config), WithProfile("wrong"), WithKey(test.FakeAPIKey))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, err := client.ListSources(testContext(t), ""); err != nil {
+		t.Errorf("expected explicit key to override the profile, got %v", err)
+	}
+
+	if _, err := New(WithConfigFile(config), WithProfile("missing")); err == nil ||
+		!strings.Contains(err.Error(), "expected one of: command, file, wrong") {
+		t.Errorf("expected an error listing the profiles, got %v", err)
+	}
+}
+
+// TestProfileEnvironment is not parallel, since it sets environment variables.
+func TestProfileEnvironment(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(config, []byte("profiles:\n  staging:\n    endpoint: https://staging.example.com/api/v1\n    key: profile-key\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("UNSTRUCTURED_CONFIG", config)
+	t.Setenv("UNSTRUCTURED_PROFILE", "staging")
+	t.Setenv("UNSTRUCTURED_API_KEY", "env-key")
+	t.Setenv("UNSTRUCTURED_API_URL", "")
+
+	client, err := New()
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if err := errors.Join(
+		eq("endpoint", client.endpoint.String(), "https://staging.example.com/api/v1"),
+		eq("key", client.key, "env-key"),
+	); err != nil {
+		t.Error(err)
+	}
+
+	// a profile selected explicitly takes precedence over the environment.
+	client, err = New(WithProfile("staging"))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if err := eq("explicit profile key", client.key, "profile-key"); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestKeyCommandTimeout is not parallel, since it changes the key command timeout.
+func TestKeyCommandTimeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("key command uses sh")
+	}
+
+	timeout := keyCommandTimeout
+	keyCommandTimeout = 100 * time.Millisecond
+
+	t.Cleanup(func() { keyCommandTimeout = timeout })
+
+	start := time.Now()
+
+	_, err := Profile{KeyCommand: "sleep 10"}.apiKey()
+	if err == nil || !strings.Contains(err.Error(), "did not finish") {
+		t.Errorf("expected the key command to time out, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the key command to be killed, it ran for %s", elapsed)
+	}
+}
@@ -1,5 +1,10 @@
 package unstructured
 
+import (
+	"fmt"
+	"slices"
+)
+
 // Provider represents an AI model provider.
 type Provider string
 
@@ -30,25 +35,79 @@ const (
 	ModelBedrockLlama3290B     Model = "us.meta.llama3-2-90b-instruct-v1:0"
 )
 
-func init() { var _ = providerModels }
-
-var providerModels = map[Provider][]Model{
-	ProviderOpenAI: {
-		ModelGPT4o,
-		ModelGPT4oMini,
-	},
-	ProviderAnthropic: {
-		ModelClaude35Sonnet,
-		ModelClaude37Sonnet,
-	},
-	ProviderBedrock: {
-		ModelBedrockNovaLite,
-		ModelBedrockNovaPro,
-		ModelBedrockClaude3Opus,
-		ModelBedrockClaude3Haiku,
-		ModelBedrockClaude3Sonnet,
-		ModelBedrockClaude35Sonnet,
-		ModelBedrockLlama3211B,
-		ModelBedrockLlama3290B,
-	},
+// ModelInfo describes a model that partitioners and enrichers can use, see [ProviderModels].
+type ModelInfo struct {
+	Model    Model    `json:"model"`
+	Provider Provider `json:"provider"`
+
+	// Vision reports whether the model takes images, which VLM partitioning and image enrichment need.
+	Vision bool `json:"vision"`
+}
+
+var providerModels = []ModelInfo{
+	{ModelGPT4o, ProviderOpenAI, true},
+	{ModelGPT4oMini, ProviderOpenAI, true},
+
+	{ModelClaude35Sonnet, ProviderAnthropic, true},
+	{ModelClaude37Sonnet, ProviderAnthropic, true},
+
+	{ModelBedrockNovaLite, ProviderBedrock, true},
+	{ModelBedrockNovaPro, ProviderBedrock, true},
+	{ModelBedrockClaude3Opus, ProviderBedrock, true},
+	{ModelBedrockClaude3Haiku, ProviderBedrock, true},
+	{ModelBedrockClaude3Sonnet, ProviderBedrock, true},
+	{ModelBedrockClaude35Sonnet, ProviderBedrock, true},
+	{ModelBedrockLlama3211B, ProviderBedrock, true},
+	{ModelBedrockLlama3290B, ProviderBedrock, true},
+}
+
+// ProviderModels returns the models the given provider offers, or the models of all providers
+// if it is empty or [ProviderAuto].
+func ProviderModels(provider Provider) []ModelInfo {
+	models := make([]ModelInfo, 0, len(providerModels))
+
+	for _, m := range providerModels {
+		if provider == "" || provider == ProviderAuto || m.Provider == provider {
+			models = append(models, m)
+		}
+	}
+
+	return models
+}
+
+// LookupModel returns the description of the given model, if it is known.
+func LookupModel(model Model) (ModelInfo, bool) {
+	i := slices.IndexFunc(providerModels, func(m ModelInfo) bool { return m.Model == model })
+	if i < 0 {
+		return ModelInfo{}, false
+	}
+
+	return providerModels[i], true
+}
+
+// validateVisionModel validates that the provider offers the model and that the model takes images.
+// Either may be empty, to let the API choose.
+func validateVisionModel(provider Provider, model Model) error {
+	switch provider {
+	case "", ProviderAuto, ProviderAnthropic, ProviderOpenAI, ProviderBedrock:
+	default:
+		return fmt.Errorf("unknown provider: %s", provider)
+	}
+
+	if model == "" {
+		return nil
+	}
+
+	m, ok := LookupModel(model)
+
+	switch {
+	case !ok:
+		return fmt.Errorf("unknown model: %s", model)
+	case provider != "" && provider != ProviderAuto && m.Provider != provider:
+		return fmt.Errorf("invalid model %s for provider %s", model, provider)
+	case !m.Vision:
+		return fmt.Errorf("model %s does not support vision", model)
+	}
+
+	return nil
 }
@@ -0,0 +1,89 @@
+package unstructured
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestProviderModels(t *testing.T) {
+	t.Parallel()
+
+	var bedrock []Model
+	for _, m := range ProviderModels(ProviderBedrock) {
+		bedrock = append(bedrock, m.Model)
+	}
+
+	m, ok := LookupModel(ModelClaude37Sonnet)
+
+	if err := errors.Join(
+		eq("all models", len(ProviderModels("")), 12),
+		eq("auto models", len(ProviderModels(ProviderAuto)), 12),
+		eq("openai models", len(ProviderModels(ProviderOpenAI)), 2),
+		eq("bedrock first model", bedrock[0], ModelBedrockNovaLite),
+		eq("found", ok, true),
+		eq("model", m, ModelInfo{Model: ModelClaude37Sonnet, Provider: ProviderAnthropic, Vision: true}),
+	); err != nil {
+		t.Error(err)
+	}
+
+	if _, ok := LookupModel("gpt-2"); ok {
+		t.Error("expected an unknown model not to be found")
+	}
+}
+
+func TestEnrichments(t *testing.T) {
+	t.Parallel()
+
+	types := func(infos []EnrichmentInfo) []EnrichmentType {
+		out := make([]EnrichmentType, len(infos))
+		for i, info := range infos {
+			out[i] = info.Type
+		}
+
+		return out
+	}
+
+	if err := errors.Join(
+		eqs("anthropic", types(Enrichments(ProviderAnthropic, "")),
+			[]EnrichmentType{EnrichmentTypeImageAnthropic, EnrichmentTypeTableAnthropic, EnrichmentTypeNERAnthropic}),
+		eqs("images", types(Enrichments("", EnrichmentModalityImage)),
+			[]EnrichmentType{EnrichmentTypeImageOpenAI, EnrichmentTypeImageAnthropic, EnrichmentTypeImageBedrock}),
+		eqs("bedrock ner", types(Enrichments(ProviderBedrock, EnrichmentModalityNER)), nil),
+		eqs("table2html", types(Enrichments("", EnrichmentModalityTable2HTML)), []EnrichmentType{EnrichmentTypeTable2HTMLOpenAI}),
+		eq("table2html is a table enrichment", Enricher{Subtype: EnrichmentTypeTable2HTMLOpenAI}.isTable(), true),
+		eq("unknown is not an image enrichment", Enricher{Subtype: "image_magic"}.isImage(), false),
+	); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValidateModels(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name  string
+		nodes WorkflowNodes
+		valid bool
+	}{
+		{"vlm", WorkflowNodes{&PartitionerVLM{Provider: ProviderAnthropic, Model: ModelClaude37Sonnet}}, true},
+		{"vlm without model", WorkflowNodes{&PartitionerVLM{Provider: ProviderOpenAI}}, true},
+		{"vlm with auto provider", WorkflowNodes{&PartitionerVLM{Provider: ProviderAuto, Model: ModelBedrockNovaPro}}, true},
+		{"vlm with model of another provider", WorkflowNodes{&PartitionerVLM{Provider: ProviderOpenAI, Model: ModelClaude37Sonnet}}, false},
+		{"vlm with unknown provider", WorkflowNodes{&PartitionerVLM{Provider: "mistral"}}, false},
+		{"auto with unknown model", WorkflowNodes{&PartitionerAuto{Provider: ProviderOpenAI, Model: "gpt-2"}}, false},
+		{"enricher", WorkflowNodes{&PartitionerFast{}, &Enricher{Subtype: EnrichmentTypeNEROpenAI}, &ChunkerTitle{}}, true},
+		{"unknown enricher", WorkflowNodes{&PartitionerFast{}, &Enricher{Subtype: "openai_summary"}, &ChunkerTitle{}}, false},
+		{"embedder", WorkflowNodes{&PartitionerFast{}, &ChunkerTitle{}, &Embedder{Subtype: EmbedderSubtypeVoyageAI, ModelName: "ada"}}, false},
+		{"order", WorkflowNodes{&ChunkerTitle{}}, false},
+		{"value enricher", WorkflowNodes{&PartitionerFast{}, Enricher{Subtype: EnrichmentTypeNEROpenAI}, ChunkerTitle{}}, true},
+		{"unknown value enricher", WorkflowNodes{&PartitionerFast{}, Enricher{Subtype: "openai_summary"}, ChunkerTitle{}}, false},
+		{"value embedder", WorkflowNodes{&PartitionerFast{}, ChunkerTitle{}, Embedder{Subtype: EmbedderSubtypeVoyageAI, ModelName: "ada"}}, false},
+		{"value nodes order", WorkflowNodes{&PartitionerFast{}, Embedder{Subtype: EmbedderSubtypeBedrock}}, false},
+		{"two value image enrichers", WorkflowNodes{&PartitionerFast{},
+			Enricher{Subtype: EnrichmentTypeImageOpenAI}, Enricher{Subtype: EnrichmentTypeImageAnthropic}, ChunkerTitle{}}, false},
+	} {
+		if err := tt.nodes.Validate(); (err == nil) != tt.valid {
+			t.Errorf("%s: expected valid to be %t, got %v", tt.name, tt.valid, err)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package unstructured
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go/test"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	ctx := testContext(t)
+	cassette := filepath.Join(t.TempDir(), "cassette.jsonl")
+
+	server := httptest.NewServer(test.NewFakeServer())
+	t.Cleanup(server.Close)
+
+	recordclient := func(rec *test.Recorder) *Client {
+		c, err := New(
+			WithClient(&http.Client{Transport: rec}),
+			WithEndpoint(server.URL+"/api/v1"),
+			WithKey(test.FakeAPIKey),
+		)
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+
+		return c
+	}
+
+	create := func(c *Client, name, secret string) (*Source, error) {
+		return c.CreateSource(ctx, CreateSourceRequest{
+			Name: name,
+			Config: &S3ConnectorConfig{
+				RemoteURL: "s3://docs/input/",
+				Key:       String("AKIA123"),
+				Secret:    String(secret),
+			},
+		})
+	}
+
+	rec, err := test.NewRecorder(cassette, test.ModeAuto, server.Client().Transport)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+
+	if !rec.Recording() {
+		t.Fatal("expected recorder to record when the cassette does not exist")
+	}
+
+	client := recordclient(rec)
+
+	recorded, err := create(client, "docs", "super-secret-value")
+	if err != nil {
+		t.Fatalf("failed to create source: %v", err)
+	}
+
+	if _, err := client.GetSource(ctx, recorded.ID); err != nil {
+		t.Fatalf("failed to get source: %v", err)
+	}
+
+	if err := rec.Close(); err != nil {
+		t.Fatalf("failed to close recorder: %v", err)
+	}
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("failed to read cassette: %v", err)
+	}
+
+	if err := errors.Join(
+		eq("interactions", strings.Count(string(data), "\n"), 2),
+		eq("contains api key", strings.Contains(string(data), test.FakeAPIKey), false),
+		eq("contains secret", strings.Contains(string(data), "super-secret-value"), false),
+		eq("contains redacted", strings.Contains(string(data), test.Redacted), true),
+	); err != nil {
+		t.Error(err)
+	}
+
+	// replay without the server: the cassette must answer every request.
+	server.Close()
+
+	rec, err = test.NewRecorder(cassette, test.ModeAuto, nil)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+
+	if rec.Recording() {
+		t.Fatal("expected recorder to replay an existing cassette")
+	}
+
+	client = recordclient(rec)
+
+	if _, err := create(client, "other", "another-secret"); err == nil {
+		t.Error("expected request with a different body not to match")
+	}
+
+	replayed, err := create(client, "docs", "another-secret")
+	if err != nil {
+		t.Fatalf("failed to replay create source: %v", err)
+	}
+
+	source, err := client.GetSource(ctx, recorded.ID)
+	if err != nil {
+		t.Fatalf("failed to replay get source: %v", err)
+	}
+
+	if _, err := client.GetSource(ctx, recorded.ID); err == nil {
+		t.Error("expected interactions to be replayed only once")
+	}
+
+	if err := errors.Join(
+		eq("replayed.id", replayed.ID, recorded.ID),
+		eq("source.id", source.ID, recorded.ID),
+		eq("source.name", source.Name, "docs"),
+	); err != nil {
+		t.Error(err)
+	}
+
+	// looser matching ignores the body.
+	rec, err = test.NewRecorder(cassette, test.ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+
+	rec.Match = test.Match{Method: true, Path: true}
+
+	if _, err := create(recordclient(rec), "other", "another-secret"); err != nil {
+		t.Errorf("expected request to match without comparing bodies: %v", err)
+	}
+}
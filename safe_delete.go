@@ -0,0 +1,139 @@
+package unstructured
+
+import (
+	"context"
+	"fmt"
+)
+
+// DependentWorkflowAction controls what a safe delete does with workflows that still reference the connector.
+type DependentWorkflowAction string
+
+const (
+	// DependentWorkflowsRefuse refuses to delete a connector that is referenced by any workflow.
+	// This is the default.
+	DependentWorkflowsRefuse DependentWorkflowAction = ""
+	// DependentWorkflowsDelete deletes each dependent workflow before deleting the connector.
+	DependentWorkflowsDelete DependentWorkflowAction = "delete"
+)
+
+// SafeDeleteRequest represents the request to delete a connector only after checking which workflows depend on it.
+type SafeDeleteRequest struct {
+	ID string
+
+	// OnDependents selects what to do with workflows that reference the connector.
+	// The Unstructured API has no way to deactivate a workflow or to clear its source or destination,
+	// so dependent workflows must be deleted, or updated by the caller to use another connector.
+	OnDependents DependentWorkflowAction
+
+	// DryRun reports what would happen without changing anything.
+	DryRun bool
+}
+
+// SafeDeleteResult describes what a safe delete did, or would do when run as a dry run.
+type SafeDeleteResult struct {
+	ConnectorID string
+	Kind        ConnectorKind
+	DryRun      bool
+
+	// Dependents lists the workflows that referenced the connector.
+	Dependents []Workflow
+
+	// Steps lists, in order, the changes made or planned.
+	Steps []string
+
+	// Deleted reports whether the connector was deleted.
+	Deleted bool
+}
+
+// SafeDeleteSource deletes a source connector after checking which workflows reference it.
+// If any workflow references the source and in.OnDependents is [DependentWorkflowsRefuse],
+// it returns a [*DependentWorkflowsError] listing those workflows and deletes nothing.
+func (c *Client) SafeDeleteSource(ctx context.Context, in SafeDeleteRequest) (*SafeDeleteResult, error) {
+	return c.safeDelete(ctx, in, ConnectorKindSource)
+}
+
+// SafeDeleteDestination deletes a destination connector after checking which workflows reference it.
+// If any workflow references the destination and in.OnDependents is [DependentWorkflowsRefuse],
+// it returns a [*DependentWorkflowsError] listing those workflows and deletes nothing.
+func (c *Client) SafeDeleteDestination(ctx context.Context, in SafeDeleteRequest) (*SafeDeleteResult, error) {
+	return c.safeDelete(ctx, in, ConnectorKindDestination)
+}
+
+func (c *Client) safeDelete(ctx context.Context, in SafeDeleteRequest, kind ConnectorKind) (*SafeDeleteResult, error) {
+	filter := ListWorkflowsRequest{SourceID: &in.ID}
+	remove := c.DeleteSource
+
+	if kind == ConnectorKindDestination {
+		filter = ListWorkflowsRequest{DestinationID: &in.ID}
+		remove = c.DeleteDestination
+	}
+
+	dependents, err := c.listAllWorkflows(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := SafeDeleteResult{
+		ConnectorID: in.ID,
+		Kind:        kind,
+		DryRun:      in.DryRun,
+		Dependents:  dependents,
+	}
+
+	if len(dependents) > 0 {
+		switch in.OnDependents {
+		case DependentWorkflowsRefuse:
+			return &result, &DependentWorkflowsError{
+				ConnectorID: in.ID,
+				Kind:        kind,
+				Workflows:   dependents,
+			}
+
+		case DependentWorkflowsDelete:
+			// handled below
+
+		default:
+			return nil, fmt.Errorf("unknown dependent workflow action: %q", in.OnDependents)
+		}
+	}
+
+	for _, wf := range dependents {
+		result.Steps = append(result.Steps, fmt.Sprintf("delete workflow %q (%s)", wf.Name, wf.ID))
+
+		if !in.DryRun {
+			if err := c.DeleteWorkflow(ctx, wf.ID); err != nil {
+				return &result, err
+			}
+		}
+	}
+
+	result.Steps = append(result.Steps, fmt.Sprintf("delete %s %s", kind, in.ID))
+
+	if in.DryRun {
+		return &result, nil
+	}
+
+	// workflows created since the listing would be left without their connector, so check again.
+	if len(dependents) > 0 {
+		remaining, err := c.listAllWorkflows(ctx, filter)
+		if err != nil {
+			return &result, err
+		}
+
+		if len(remaining) > 0 {
+			return &result, &DependentWorkflowsError{
+				ConnectorID: in.ID,
+				Kind:        kind,
+				Workflows:   remaining,
+			}
+		}
+	}
+
+	if err := remove(ctx, in.ID); err != nil {
+		return &result, err
+	}
+
+	result.Deleted = true
+
+	return &result, nil
+}
@@ -0,0 +1,245 @@
+package unstructured
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSafeDeleteSource(t *testing.T) {
+	t.Parallel()
+
+	const (
+		sourceID   = "f1f7b1b2-8e4b-4a2b-8f1d-3e3c7c9e5a3c"
+		workflowID = "16b80fee-64dc-472d-8f26-1d7729b6423d"
+	)
+
+	setup := func(t *testing.T) (*Client, *atomic.Int32, *atomic.Int32) {
+		client, mux := testclient(t)
+
+		var deletes, workflowDeletes atomic.Int32
+
+		mux.ListWorkflows = func(w http.ResponseWriter, r *http.Request) {
+			if val := r.URL.Query().Get("source_id"); val != sourceID {
+				http.Error(w, "unexpected source_id "+val, http.StatusBadRequest)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+
+			if workflowDeletes.Load() > 0 {
+				w.Write([]byte(`[]`))
+				return
+			}
+
+			w.Write([]byte(`[{` +
+				`  "id": "` + workflowID + `",` +
+				`  "name": "nightly-ingest",` +
+				`  "sources": ["` + sourceID + `"],` +
+				`  "destinations": [],` +
+				`  "workflow_nodes": [],` +
+				`  "status": "active"` +
+				`}]`))
+		}
+
+		mux.DeleteWorkflow = func(w http.ResponseWriter, r *http.Request) {
+			if deletes.Load() > 0 {
+				http.Error(w, "workflow deleted after its source", http.StatusBadRequest)
+				return
+			}
+
+			workflowDeletes.Add(1)
+			w.WriteHeader(http.StatusOK)
+		}
+
+		mux.DeleteSource = func(w http.ResponseWriter, r *http.Request) {
+			deletes.Add(1)
+			w.WriteHeader(http.StatusOK)
+		}
+
+		return client, &deletes, &workflowDeletes
+	}
+
+	t.Run("refuse", func(t *testing.T) {
+		t.Parallel()
+
+		client, deletes, _ := setup(t)
+
+		result, err := client.SafeDeleteSource(testContext(t), SafeDeleteRequest{ID: sourceID})
+
+		var depErr *DependentWorkflowsError
+		if !errors.As(err, &depErr) {
+			t.Fatalf("expected error to be an %T, got %T: %v", depErr, err, err)
+		}
+
+		if err := errors.Join(
+			eq("error.kind", depErr.Kind, ConnectorKindSource),
+			eq("len(error.workflows)", len(depErr.Workflows), 1),
+			eq("result.deleted", result.Deleted, false),
+			eq("deletes", deletes.Load(), 0),
+		); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		t.Parallel()
+
+		client, deletes, workflowDeletes := setup(t)
+
+		result, err := client.SafeDeleteSource(testContext(t), SafeDeleteRequest{
+			ID:           sourceID,
+			OnDependents: DependentWorkflowsDelete,
+			DryRun:       true,
+		})
+		if err != nil {
+			t.Fatalf("failed to plan safe delete: %v", err)
+		}
+
+		if err := errors.Join(
+			eqs("result.steps", result.Steps, []string{
+				`delete workflow "nightly-ingest" (` + workflowID + `)`,
+				"delete source " + sourceID,
+			}),
+			eq("result.deleted", result.Deleted, false),
+			eq("deletes", deletes.Load(), 0),
+			eq("workflow deletes", workflowDeletes.Load(), 0),
+		); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		t.Parallel()
+
+		client, deletes, workflowDeletes := setup(t)
+
+		result, err := client.SafeDeleteSource(testContext(t), SafeDeleteRequest{
+			ID:           sourceID,
+			OnDependents: DependentWorkflowsDelete,
+		})
+		if err != nil {
+			t.Fatalf("failed to safe delete source: %v", err)
+		}
+
+		if err := errors.Join(
+			eq("result.deleted", result.Deleted, true),
+			eq("deletes", deletes.Load(), 1),
+			eq("workflow deletes", workflowDeletes.Load(), 1),
+		); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("unknown action", func(t *testing.T) {
+		t.Parallel()
+
+		client, deletes, _ := setup(t)
+
+		if _, err := client.SafeDeleteSource(testContext(t), SafeDeleteRequest{
+			ID:           sourceID,
+			OnDependents: "detach",
+		}); err == nil {
+			t.Error("expected detaching dependent workflows to be unsupported")
+		}
+
+		if err := eq("deletes", deletes.Load(), 0); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestSafeDeleteDestination(t *testing.T) {
+	t.Parallel()
+
+	// more dependents than fit on the API's default page of 20 workflows.
+	const dependents = 25
+
+	setup := func(t *testing.T) (*Client, string) {
+		client, _ := fakeclient(t)
+		ctx := testContext(t)
+
+		source, err := client.CreateSource(ctx, CreateSourceRequest{
+			Name:   "docs",
+			Config: &S3ConnectorConfig{RemoteURL: "s3://docs/input/"},
+		})
+		if err != nil {
+			t.Fatalf("failed to create source: %v", err)
+		}
+
+		destination, err := client.CreateDestination(ctx, CreateDestinationRequest{
+			Name:   "output",
+			Config: &S3ConnectorConfig{RemoteURL: "s3://docs/output/"},
+		})
+		if err != nil {
+			t.Fatalf("failed to create destination: %v", err)
+		}
+
+		for i := range dependents {
+			if _, err := client.CreateWorkflow(ctx, &CreateWorkflowRequest{
+				Name:          fmt.Sprintf("ingest-%d", i),
+				SourceID:      &source.ID,
+				DestinationID: &destination.ID,
+				WorkflowNodes: []WorkflowNode{&PartitionerFast{Name: "Partitioner"}},
+			}); err != nil {
+				t.Fatalf("failed to create workflow: %v", err)
+			}
+		}
+
+		return client, destination.ID
+	}
+
+	t.Run("refuse", func(t *testing.T) {
+		t.Parallel()
+
+		client, id := setup(t)
+
+		_, err := client.SafeDeleteDestination(testContext(t), SafeDeleteRequest{ID: id})
+
+		var depErr *DependentWorkflowsError
+		if !errors.As(err, &depErr) {
+			t.Fatalf("expected error to be an %T, got %T: %v", depErr, err, err)
+		}
+
+		if _, err := client.GetDestination(testContext(t), id); err != nil {
+			t.Errorf("expected destination to be kept: %v", err)
+		}
+
+		if err := errors.Join(
+			eq("error.kind", depErr.Kind, ConnectorKindDestination),
+			eq("len(error.workflows)", len(depErr.Workflows), dependents),
+		); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		t.Parallel()
+
+		client, id := setup(t)
+		ctx := testContext(t)
+
+		result, err := client.SafeDeleteDestination(ctx, SafeDeleteRequest{
+			ID:           id,
+			OnDependents: DependentWorkflowsDelete,
+		})
+		if err != nil {
+			t.Fatalf("failed to safe delete destination: %v", err)
+		}
+
+		remaining, err := client.ListWorkflows(ctx, &ListWorkflowsRequest{DestinationID: &id})
+		if err != nil {
+			t.Fatalf("failed to list workflows: %v", err)
+		}
+
+		if err := errors.Join(
+			eq("result.deleted", result.Deleted, true),
+			eq("len(result.dependents)", len(result.Dependents), dependents),
+			eq("len(remaining)", len(remaining), 0),
+		); err != nil {
+			t.Error(err)
+		}
+	})
+}
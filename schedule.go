@@ -0,0 +1,454 @@
+package unstructured
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule presets accepted by the API for [CreateWorkflowRequest] and [UpdateWorkflowRequest].
+const (
+	ScheduleEvery15Minutes = "every 15 minutes"
+	ScheduleEveryHour      = "every hour"
+	ScheduleEvery2Hours    = "every 2 hours"
+	ScheduleEvery4Hours    = "every 4 hours"
+	ScheduleEvery6Hours    = "every 6 hours"
+	ScheduleEvery8Hours    = "every 8 hours"
+	ScheduleEvery10Hours   = "every 10 hours"
+	ScheduleEvery12Hours   = "every 12 hours"
+	ScheduleDaily          = "daily"
+	ScheduleWeekly         = "weekly"
+	ScheduleMonthly        = "monthly"
+)
+
+// schedulePresets maps the presets to the cron expressions the API reports for them in [WorkflowSchedule].
+var schedulePresets = []struct{ name, cron string }{
+	{ScheduleEvery15Minutes, "*/15 * * * *"},
+	{ScheduleEveryHour, "0 * * * *"},
+	{ScheduleEvery2Hours, "0 */2 * * *"},
+	{ScheduleEvery4Hours, "0 */4 * * *"},
+	{ScheduleEvery6Hours, "0 */6 * * *"},
+	{ScheduleEvery8Hours, "0 */8 * * *"},
+	{ScheduleEvery10Hours, "0 */10 * * *"},
+	{ScheduleEvery12Hours, "0 */12 * * *"},
+	{ScheduleDaily, "0 0 * * *"},
+	{ScheduleWeekly, "0 0 * * 0"},
+	{ScheduleMonthly, "0 0 1 * *"},
+}
+
+// Schedule is a parsed workflow schedule: a standard cron expression, with the fields
+// minute, hour, day of month, month and day of week, or one of the presets of the API, like "daily".
+// The zero value is not a valid schedule; use [ParseSchedule].
+type Schedule struct {
+	expr   string
+	fields [5]uint64
+
+	// a day matches either of the day of month and the day of week if both are restricted.
+	domAll, dowAll bool
+}
+
+// cronField describes a field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var cronFields = [5]cronField{
+	{"minute", 0, 59, nil},
+	{"hour", 0, 23, nil},
+	{"day of month", 1, 31, nil},
+	{"month", 1, 12, []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{"day of week", 0, 7, []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+// ParseSchedule parses a cron expression or a schedule preset, as set on a [CreateWorkflowRequest]
+// or reported in a [CronTabEntry]. Fields of cron expressions may be lists of values, ranges and steps,
+// like "*/15", "1-5" or "0,30", and months and days of the week may be named, like "jan" or "mon-fri".
+// A day of week of 7 is Sunday, like 0.
+func ParseSchedule(s string) (Schedule, error) {
+	expr := strings.ToLower(strings.Join(strings.Fields(s), " "))
+
+	if i := slices.IndexFunc(schedulePresets, func(p struct{ name, cron string }) bool { return p.name == expr }); i >= 0 {
+		expr = schedulePresets[i].cron
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFields) {
+		return Schedule{}, fmt.Errorf("invalid schedule %q: expected a preset or a cron expression of 5 fields", s)
+	}
+
+	// like cron, a day field that starts with a star, like */2, does not restrict the days on its own.
+	sched := Schedule{expr: expr, domAll: strings.HasPrefix(parts[2], "*"), dowAll: strings.HasPrefix(parts[4], "*")}
+
+	for i, part := range parts {
+		set, err := cronFields[i].parse(part)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("invalid schedule %q: %w", s, err)
+		}
+
+		sched.fields[i] = set
+	}
+
+	// Sunday is both 0 and 7.
+	if sched.fields[4]&(1<<7) != 0 {
+		sched.fields[4] = sched.fields[4]&^(1<<7) | 1
+	}
+
+	return sched, nil
+}
+
+// parse returns the set of values of the field, as a bit set.
+func (f cronField) parse(s string) (uint64, error) {
+	var set uint64
+
+	for _, item := range strings.Split(s, ",") {
+		rng, stepText, hasStep := strings.Cut(item, "/")
+
+		step := 1
+
+		if hasStep {
+			n, err := strconv.Atoi(stepText)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepText, f.name)
+			}
+
+			step = n
+		}
+
+		lo, hi := f.min, f.max
+
+		switch {
+		case rng == "*":
+			// Sunday is only counted once in a full range of days of the week.
+			if f.max == 7 {
+				hi = 6
+			}
+
+		case strings.Contains(rng, "-"):
+			a, b, _ := strings.Cut(rng, "-")
+
+			var err error
+			if lo, err = f.value(a); err != nil {
+				return 0, err
+			}
+
+			if hi, err = f.value(b); err != nil {
+				return 0, err
+			}
+
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q in %s field", rng, f.name)
+			}
+
+		default:
+			v, err := f.value(rng)
+			if err != nil {
+				return 0, err
+			}
+
+			lo = v
+
+			// a single value with a step, like 5/15, runs until the end of the range.
+			if hasStep {
+				hi = f.max
+			} else {
+				hi = v
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+
+	return set, nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if i := slices.Index(f.names, s); i >= 0 {
+		return i + f.min, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q in %s field, expected %d to %d", s, f.name, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+// String returns the cron expression of the schedule.
+func (s Schedule) String() string { return s.expr }
+
+// Preset returns the preset of the API that the schedule is equivalent to, if any.
+func (s Schedule) Preset() (string, bool) {
+	for _, p := range schedulePresets {
+		if preset, _ := ParseSchedule(p.cron); preset.fields == s.fields && preset.domAll == s.domAll && preset.dowAll == s.dowAll {
+			return p.name, true
+		}
+	}
+
+	return "", false
+}
+
+// RequestValue returns the schedule as set on a [CreateWorkflowRequest] or an [UpdateWorkflowRequest].
+// The API only accepts its presets, so schedules without an equivalent preset return an error.
+func (s Schedule) RequestValue() (*string, error) {
+	preset, ok := s.Preset()
+	if !ok {
+		return nil, fmt.Errorf("schedule %q has no equivalent preset, expected one of: %s", s.expr, presetNames())
+	}
+
+	return &preset, nil
+}
+
+// CronTabEntry returns the schedule as the API reports it in a [WorkflowSchedule].
+func (s Schedule) CronTabEntry() CronTabEntry {
+	return CronTabEntry{CronExpression: s.expr}
+}
+
+// Schedules parses the cron tab entries of the workflow schedule.
+func (w *WorkflowSchedule) Schedules() ([]Schedule, error) {
+	var (
+		schedules []Schedule
+		errs      []error
+	)
+
+	for _, entry := range w.CronTabEntries {
+		s, err := ParseSchedule(entry.CronExpression)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		schedules = append(schedules, s)
+	}
+
+	return schedules, errors.Join(errs...)
+}
+
+// validateScheduleRequest validates the schedule of a workflow request before it is sent.
+func validateScheduleRequest(schedule *string) error {
+	if schedule == nil {
+		return nil
+	}
+
+	s, err := ParseSchedule(*schedule)
+	if err != nil {
+		return err
+	}
+
+	// the API only accepts the exact names of presets.
+	if preset, ok := s.Preset(); !ok || preset != *schedule {
+		if ok {
+			return fmt.Errorf("invalid schedule %q: use the preset %q instead", *schedule, preset)
+		}
+
+		return fmt.Errorf("invalid schedule %q: expected one of: %s", *schedule, presetNames())
+	}
+
+	return nil
+}
+
+func presetNames() string {
+	names := make([]string, len(schedulePresets))
+	for i, p := range schedulePresets {
+		names[i] = p.name
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// Next returns the next n times the schedule fires after from, evaluating the schedule in the time zone loc,
+// or in the location of from if loc is nil.
+// Like cron, times that do not exist because clocks spring forward are skipped,
+// and times that repeat because clocks fall back fire once, the first time.
+func (s Schedule) Next(from time.Time, n int, loc *time.Location) []time.Time {
+	if loc == nil {
+		loc = from.Location()
+	}
+
+	var (
+		times []time.Time
+		fired time.Time
+	)
+
+	t := from.In(loc).Truncate(time.Minute).Add(time.Minute)
+
+	// schedules that never fire, like on February 30, are given up on after a few years.
+	limit := t.AddDate(5, 0, 0)
+
+	for len(times) < n && t.Before(limit) {
+		prev := t
+
+		switch {
+		case !s.has(3, int(t.Month())):
+			t = advance(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc))
+		case !s.matchDay(t):
+			t = advance(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc))
+		case !s.has(1, t.Hour()):
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+		case !s.has(0, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			if wall := wallClock(t); wall.After(fired) {
+				times = append(times, t)
+				fired = wall
+			}
+
+			t = t.Add(time.Minute)
+		}
+
+		if !t.After(prev) {
+			break
+		}
+	}
+
+	return times
+}
+
+// advance returns next if it is after t, or else the start of the next hour after t.
+// Midnight may not exist on days that clocks spring forward, and [time.Date] may then normalize it to before t.
+func advance(t, next time.Time) time.Time {
+	if next.After(t) {
+		return next
+	}
+
+	return t.Add(time.Duration(60-t.Minute()) * time.Minute)
+}
+
+// wallClock returns the wall clock time of t, so that times that repeat when clocks fall back compare equal.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, time.UTC)
+}
+
+func (s Schedule) has(field, v int) bool { return s.fields[field]&(1<<v) != 0 }
+
+func (s Schedule) matchDay(t time.Time) bool {
+	dom, dow := s.has(2, t.Day()), s.has(4, int(t.Weekday()))
+
+	if s.domAll || s.dowAll {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// Describe describes the schedule in words, like "every 2 hours" or "at 09:30 on Monday, Friday".
+func (s Schedule) Describe() string {
+	minutes, hours := s.values(0), s.values(1)
+
+	var when string
+
+	switch {
+	case len(minutes) == 60 && len(hours) == 24:
+		when = "every minute"
+	case len(hours) == 24 && isStep(minutes, 60) > 1:
+		when = fmt.Sprintf("every %d minutes", isStep(minutes, 60))
+	case len(minutes) == 1 && len(hours) == 24:
+		when = "every hour" + atMinute(minutes[0])
+	case len(minutes) == 1 && isStep(hours, 24) > 1:
+		when = fmt.Sprintf("every %d hours", isStep(hours, 24)) + atMinute(minutes[0])
+	case len(minutes)*len(hours) <= 4:
+		var times []string
+
+		for _, h := range hours {
+			for _, m := range minutes {
+				times = append(times, fmt.Sprintf("%02d:%02d", h, m))
+			}
+		}
+
+		when = "at " + strings.Join(times, ", ")
+	default:
+		when = fmt.Sprintf("at minute %s past hour %s", join(minutes, nil), join(hours, nil))
+	}
+
+	var days []string
+
+	if dom := s.values(2); len(dom) < 31 {
+		days = append(days, "on day "+join(dom, nil)+" of the month")
+	}
+
+	if dow := s.values(4); len(dow) < 7 {
+		days = append(days, "on "+join(dow, weekdayName))
+	}
+
+	// a day matches both fields if either starts with a star, and either field otherwise.
+	if s.domAll || s.dowAll {
+		when += " " + strings.Join(days, " and ")
+	} else {
+		when += " " + strings.Join(days, " or ")
+	}
+
+	if len(days) == 0 && !strings.HasPrefix(when, "every") {
+		when += "every day"
+	}
+
+	if months := s.values(3); len(months) < 12 {
+		when += " in " + join(months, monthName)
+	}
+
+	return strings.TrimSpace(when)
+}
+
+// values lists the values of a field.
+func (s Schedule) values(field int) []int {
+	var values []int
+
+	for set := s.fields[field]; set != 0; set &= set - 1 {
+		values = append(values, bits.TrailingZeros64(set))
+	}
+
+	return values
+}
+
+// isStep returns n if values are every n-th value from 0 up to size, or 0 otherwise.
+func isStep(values []int, size int) int {
+	if len(values) < 2 || values[0] != 0 {
+		return 0
+	}
+
+	step := values[1]
+
+	for i, v := range values {
+		if v != i*step {
+			return 0
+		}
+	}
+
+	if values[len(values)-1]+step < size {
+		return 0
+	}
+
+	return step
+}
+
+func atMinute(m int) string {
+	if m == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" at minute %d", m)
+}
+
+func join(values []int, name func(int) string) string {
+	parts := make([]string, len(values))
+
+	for i, v := range values {
+		if name != nil {
+			parts[i] = name(v)
+		} else {
+			parts[i] = strconv.Itoa(v)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func weekdayName(v int) string { return time.Weekday(v).String() }
+func monthName(v int) string   { return time.Month(v).String() }
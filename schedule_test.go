@@ -0,0 +1,217 @@
+package unstructured
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseSchedule(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		in, cron, preset, describe string
+	}{
+		{"weekly", "0 0 * * 0", ScheduleWeekly, "at 00:00 on Sunday"},
+		{"Daily", "0 0 * * *", ScheduleDaily, "at 00:00 every day"},
+		{"monthly", "0 0 1 * *", ScheduleMonthly, "at 00:00 on day 1 of the month"},
+		{"every 15 minutes", "*/15 * * * *", ScheduleEvery15Minutes, "every 15 minutes"},
+		{"every 6 hours", "0 */6 * * *", ScheduleEvery6Hours, "every 6 hours"},
+		{"0,15,30,45 * * * *", "0,15,30,45 * * * *", ScheduleEvery15Minutes, "every 15 minutes"},
+		{"0 0 * * 7", "0 0 * * 7", ScheduleWeekly, "at 00:00 on Sunday"},
+		{"0  0 * * SUN", "0 0 * * sun", ScheduleWeekly, "at 00:00 on Sunday"},
+		{"30 9 * * mon-fri", "30 9 * * mon-fri", "", "at 09:30 on Monday, Tuesday, Wednesday, Thursday, Friday"},
+		{"5 * * * *", "5 * * * *", "", "every hour at minute 5"},
+		{"0 8,20 1 jan,jul *", "0 8,20 1 jan,jul *", "", "at 08:00, 20:00 on day 1 of the month in January, July"},
+		{"0 12 13 * fri", "0 12 13 * fri", "", "at 12:00 on day 13 of the month or on Friday"},
+		{"0 9 */2 * mon", "0 9 */2 * mon", "", "at 09:00 on day 1, 3, 5, 7, 9, 11, 13, 15, 17, 19, 21, 23, 25, 27, 29, 31 of the month and on Monday"},
+		{"10-50/20 */5 * * *", "10-50/20 */5 * * *", "", "at minute 10, 30, 50 past hour 0, 5, 10, 15, 20 every day"},
+	} {
+		s, err := ParseSchedule(tt.in)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q): %v", tt.in, err)
+			continue
+		}
+
+		preset, _ := s.Preset()
+
+		if err := errors.Join(
+			eq(tt.in+" cron", s.String(), tt.cron),
+			eq(tt.in+" preset", preset, tt.preset),
+			eq(tt.in+" description", s.Describe(), tt.describe),
+		); err != nil {
+			t.Error(err)
+		}
+	}
+
+	for _, in := range []string{
+		"", "hourly", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8",
+		"*/0 * * * *", "5-1 * * * *", "* * * foo *", "1,,2 * * * *",
+	} {
+		if _, err := ParseSchedule(in); err == nil {
+			t.Errorf("ParseSchedule(%q): expected an error", in)
+		}
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	t.Parallel()
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	from := time.Date(2025, time.March, 29, 22, 10, 30, 0, time.UTC)
+
+	format := func(times []time.Time) string {
+		out := make([]string, len(times))
+		for i, t := range times {
+			out[i] = t.Format(time.RFC3339)
+		}
+
+		return strings.Join(out, " ")
+	}
+
+	for _, tt := range []struct {
+		expr string
+		loc  *time.Location
+		n    int
+		want string
+	}{
+		{"every 15 minutes", nil, 3, "2025-03-29T22:15:00Z 2025-03-29T22:30:00Z 2025-03-29T22:45:00Z"},
+		{"daily", nil, 2, "2025-03-30T00:00:00Z 2025-03-31T00:00:00Z"},
+		{"daily", paris, 2, "2025-03-30T00:00:00+01:00 2025-03-31T00:00:00+02:00"},
+		{"weekly", nil, 2, "2025-03-30T00:00:00Z 2025-04-06T00:00:00Z"},
+		{"monthly", paris, 1, "2025-04-01T00:00:00+02:00"},
+		{"0 12 13 * fri", nil, 3, "2025-04-04T12:00:00Z 2025-04-11T12:00:00Z 2025-04-13T12:00:00Z"},
+		{"0 0 29 feb *", nil, 1, "2028-02-29T00:00:00Z"},
+		{"0 0 30 feb *", nil, 1, ""},
+		{"0 9 */2 * mon", nil, 3, "2025-03-31T09:00:00Z 2025-04-07T09:00:00Z 2025-04-21T09:00:00Z"},
+	} {
+		s, err := ParseSchedule(tt.expr)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q): %v", tt.expr, err)
+		}
+
+		if err := eq(tt.expr+" next", format(s.Next(from, tt.n, tt.loc)), tt.want); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestScheduleNextDST(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	format := func(times []time.Time) string {
+		out := make([]string, len(times))
+		for i, t := range times {
+			out[i] = t.Format("01-02T15:04Z07:00")
+		}
+
+		return strings.Join(out, " ")
+	}
+
+	for _, tt := range []struct {
+		expr string
+		from time.Time
+		n    int
+		want string
+	}{
+		// clocks spring forward from 02:00 to 03:00 on March 8, 2026.
+		{"daily", time.Date(2026, time.March, 6, 12, 0, 0, 0, newYork), 4,
+			"03-07T00:00-05:00 03-08T00:00-05:00 03-09T00:00-04:00 03-10T00:00-04:00"},
+		{"30 2 * * *", time.Date(2026, time.March, 7, 0, 0, 0, 0, newYork), 2,
+			"03-07T02:30-05:00 03-09T02:30-04:00"},
+		{"every hour", time.Date(2026, time.March, 8, 0, 30, 0, 0, newYork), 3,
+			"03-08T01:00-05:00 03-08T03:00-04:00 03-08T04:00-04:00"},
+		// clocks fall back from 02:00 to 01:00 on November 1, 2026.
+		{"30 1 * * *", time.Date(2026, time.October, 31, 12, 0, 0, 0, newYork), 3,
+			"11-01T01:30-04:00 11-02T01:30-05:00 11-03T01:30-05:00"},
+		{"daily", time.Date(2026, time.October, 31, 12, 0, 0, 0, newYork), 2,
+			"11-01T00:00-04:00 11-02T00:00-05:00"},
+	} {
+		s, err := ParseSchedule(tt.expr)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q): %v", tt.expr, err)
+		}
+
+		if err := eq(tt.expr+" next", format(s.Next(tt.from, tt.n, nil)), tt.want); err != nil {
+			t.Error(err)
+		}
+	}
+
+	daily, _ := ParseSchedule(ScheduleDaily)
+	if got := daily.Next(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC), 10, newYork); len(got) != 10 {
+		t.Errorf("expected 10 daily runs across the spring forward, got %d", len(got))
+	}
+}
+
+func TestScheduleConversions(t *testing.T) {
+	t.Parallel()
+
+	s, err := ParseSchedule("0 */4 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	value, err := s.RequestValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workflow := WorkflowSchedule{CronTabEntries: []CronTabEntry{{CronExpression: "0 0 * * 0"}, s.CronTabEntry()}}
+
+	schedules, err := workflow.Schedules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := errors.Join(
+		eq("request value", *value, ScheduleEvery4Hours),
+		eq("schedules", len(schedules), 2),
+		eq("first schedule", schedules[0].String(), "0 0 * * 0"),
+		eq("second schedule", schedules[1].String(), "0 */4 * * *"),
+	); err != nil {
+		t.Error(err)
+	}
+
+	weekdays, err := ParseSchedule("30 9 * * 1-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := weekdays.RequestValue(); err == nil {
+		t.Error("expected a schedule without a preset to have no request value")
+	}
+
+	if _, err := (&WorkflowSchedule{CronTabEntries: []CronTabEntry{{CronExpression: "bogus"}}}).Schedules(); err == nil {
+		t.Error("expected an invalid cron tab entry to fail")
+	}
+}
+
+func TestValidateScheduleRequest(t *testing.T) {
+	t.Parallel()
+
+	client, _ := testclient(t)
+
+	for _, schedule := range []string{"0 0 * * *", "every 3 hours", "*/5 * * * *", "Daily", " daily "} {
+		if _, err := client.CreateWorkflow(testContext(t), &CreateWorkflowRequest{Name: "ingest", Schedule: &schedule}); err == nil {
+			t.Errorf("expected schedule %q to be rejected before the request", schedule)
+		}
+
+		if _, err := client.UpdateWorkflow(testContext(t), UpdateWorkflowRequest{ID: "id", Schedule: &schedule}); err == nil {
+			t.Errorf("expected schedule %q to be rejected before the request", schedule)
+		}
+	}
+
+	if err := validateScheduleRequest(String("every 2 hours")); err != nil {
+		t.Error(err)
+	}
+}
@@ -51,7 +51,7 @@ func (c *Client) CreateSource(ctx context.Context, in CreateSourceRequest) (*Sou
 
 	var source Source
 	if err := c.do(req, &source); err != nil {
-		return nil, fmt.Errorf("failed to create source: %w", err)
+		return nil, fmt.Errorf("failed to create source: %w", withRequest(err, in))
 	}
 
 	return &source, nil
@@ -16,6 +16,7 @@ type UpdateSourceRequest struct {
 
 // UpdateSource updates the configuration of an existing source connector.
 // It returns the updated source connector.
+// The API does not support renaming connectors, so only the config is updated.
 func (c *Client) UpdateSource(ctx context.Context, in UpdateSourceRequest) (*Source, error) {
 	config, err := json.Marshal(in.Config)
 	if err != nil {
@@ -46,7 +47,7 @@ func (c *Client) UpdateSource(ctx context.Context, in UpdateSourceRequest) (*Sou
 
 	var source Source
 	if err := c.do(req, &source); err != nil {
-		return nil, fmt.Errorf("failed to update source: %w", err)
+		return nil, fmt.Errorf("failed to update source: %w", withRequest(err, in))
 	}
 
 	return &source, nil
@@ -0,0 +1,118 @@
+//go:build integration
+
+package test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/aws-gopher/unstructured-sdk-go/chunking"
+)
+
+// golden is where the chunks the API output are kept for the tests of the chunking package.
+var golden = filepath.Join("testdata", "chunk_by_title.json")
+
+// TestChunkByTitle checks that chunking.ByTitle chunks the elements the API partitioned
+// the same way the API's chunker does.
+func TestChunkByTitle(t *testing.T) {
+	t.Parallel()
+
+	client := testClient(t, "chunk_by_title")
+	ctx := testContext(t)
+
+	chunker := &unstructured.ChunkerTitle{Name: "Chunker", IncludeOrigElements: true}
+
+	workflow, err := client.CreateWorkflow(ctx, &unstructured.CreateWorkflowRequest{
+		Name:          "chunk-by-title",
+		WorkflowNodes: []unstructured.WorkflowNode{&unstructured.PartitionerAuto{Name: "Partitioner"}, chunker},
+	})
+	if err != nil {
+		t.Fatalf("failed to create workflow: %v", err)
+	}
+
+	t.Cleanup(func() { _ = client.DeleteWorkflow(ctx, workflow.ID) })
+
+	f, err := os.Open(filepath.Join("testdata", "1706.03762v7.pdf"))
+	if err != nil {
+		t.Fatalf("failed to open file: %v", err)
+	}
+
+	t.Cleanup(func() { _ = f.Close() })
+
+	job, err := client.RunWorkflow(ctx, &unstructured.RunWorkflowRequest{ID: workflow.ID, InputFiles: []unstructured.File{f}})
+	if err != nil {
+		t.Fatalf("failed to run workflow: %v", err)
+	}
+
+	if err := client.WatchJob(ctx, job.ID, func(*unstructured.JobProgress) error { return nil }); err != nil {
+		t.Fatalf("failed to wait for job: %v", err)
+	}
+
+	job, err = client.GetJob(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("failed to get job: %v", err)
+	}
+
+	if job.Status != unstructured.JobStatusCompleted || len(job.OutputNodeFiles) == 0 {
+		t.Fatalf("expected job to complete with output, got %s with %d files", job.Status, len(job.OutputNodeFiles))
+	}
+
+	out := job.OutputNodeFiles[len(job.OutputNodeFiles)-1]
+
+	body, err := client.DownloadJob(ctx, unstructured.DownloadJobRequest{JobID: job.ID, NodeID: out.NodeID, FileID: out.FileID})
+	if err != nil {
+		t.Fatalf("failed to download job output: %v", err)
+	}
+
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatalf("failed to download job output: %v", err)
+	}
+
+	// the output is kept as the golden file of the unit tests of the chunking package.
+	if _, err := os.Stat(golden); os.Getenv("UNSTRUCTURED_RECORD") != "" || errors.Is(err, os.ErrNotExist) {
+		if err := os.WriteFile(golden, data, 0o644); err != nil {
+			t.Fatalf("failed to write golden file: %v", err)
+		}
+	}
+
+	want, err := chunking.ReadElements(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to read chunks: %v", err)
+	}
+
+	// the original elements of the chunks are the partitioned elements, without page breaks.
+	var elements []chunking.Element
+	for _, c := range want {
+		if len(elements) > 0 && slices.ContainsFunc(elements, func(e chunking.Element) bool {
+			return e.ElementID == c.Metadata.OrigElements[0].ElementID
+		}) {
+			continue
+		}
+
+		elements = append(elements, c.Metadata.OrigElements...)
+	}
+
+	got, err := chunking.ByTitle(elements, *chunker)
+	if err != nil {
+		t.Fatalf("failed to chunk: %v", err)
+	}
+
+	if len(got) != len(want) {
+		t.Errorf("expected %d chunks, got %d", len(want), len(got))
+	}
+
+	for i := range min(len(got), len(want)) {
+		if got[i].Type != want[i].Type || got[i].Text != want[i].Text {
+			t.Errorf("chunk %d: expected %s %q, got %s %q", i, want[i].Type, want[i].Text, got[i].Type, got[i].Text)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+//go:build integration
+
+package test
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+// testClient returns a client whose traffic goes through a cassette under testdata/cassettes.
+// Recorded cassettes are replayed offline; without one the test is skipped unless UNSTRUCTURED_API_KEY is set,
+// in which case the real traffic is recorded. Set UNSTRUCTURED_RECORD to re-record an existing cassette.
+func testClient(t *testing.T, cassette string) *unstructured.Client {
+	t.Helper()
+
+	path := filepath.Join("testdata", "cassettes", cassette+".jsonl")
+	key := os.Getenv("UNSTRUCTURED_API_KEY")
+
+	mode := ModeAuto
+	if os.Getenv("UNSTRUCTURED_RECORD") != "" {
+		mode = ModeRecord
+	}
+
+	if _, err := os.Stat(path); mode == ModeRecord || errors.Is(err, os.ErrNotExist) {
+		if key == "" {
+			t.Skip("skipping because UNSTRUCTURED_API_KEY is not set and there is no cassette to replay")
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create cassette directory: %v", err)
+		}
+	}
+
+	rec, err := NewRecorder(path, mode, nil)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+
+	t.Cleanup(func() { _ = rec.Close() })
+
+	if !rec.Recording() {
+		key = FakeAPIKey
+	}
+
+	client, err := unstructured.New(
+		unstructured.WithClient(&http.Client{Transport: rec}),
+		unstructured.WithKey(key),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	return client
+}
@@ -4,7 +4,6 @@ package test
 
 import (
 	"context"
-	"crypto/rand"
 	"testing"
 )
 
@@ -12,7 +11,3 @@ import (
 func testContext(t *testing.T) context.Context {
 	return t.Context()
 }
-
-func randText() string {
-	return rand.Text()
-}
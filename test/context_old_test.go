@@ -4,7 +4,6 @@ package test
 
 import (
 	"context"
-	"crypto/rand"
 	"testing"
 )
 
@@ -15,13 +14,3 @@ func testContext(t *testing.T) context.Context {
 	t.Cleanup(cancel)
 	return ctx
 }
-
-func randText() string {
-	const base32 = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
-	src := make([]byte, 26)
-	rand.Read(src)
-	for i := range src {
-		src[i] = base32[src[i]%32]
-	}
-	return string(src)
-}
@@ -0,0 +1,1123 @@
+package test
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws-gopher/unstructured-sdk-go/internal/secret"
+)
+
+// FakeServer is a stateful, in-memory implementation of the Unstructured API.
+//
+// It stores sources, destinations, workflows and jobs, answers unknown IDs with 404s,
+// rejects malformed requests with 422 validation errors shaped like the real API's,
+// masks the credentials of connectors it reads back,
+// and simulates the job lifecycle (SCHEDULED → IN_PROGRESS → COMPLETED/FAILED)
+// against a clock that only moves when [FakeServer.Advance] is called.
+//
+// FakeServer implements [http.Handler] and is meant to be served with [net/http/httptest]:
+//
+//	fake := test.NewFakeServer()
+//	server := httptest.NewServer(fake)
+//	defer server.Close()
+//
+//	client, err := unstructured.New(
+//		unstructured.WithEndpoint(server.URL+"/api/v1"),
+//		unstructured.WithKey(test.FakeAPIKey),
+//	)
+type FakeServer struct {
+	// APIKey is the key every request must carry in the Unstructured-API-Key header.
+	// If empty, requests are not authenticated.
+	APIKey string
+
+	// ScheduleDelay is how long a job stays SCHEDULED before it starts.
+	ScheduleDelay time.Duration
+
+	// RunDuration is how long a job stays IN_PROGRESS before it finishes.
+	RunDuration time.Duration
+
+	// CheckDelay is how long a connection check stays SCHEDULED before it reports.
+	CheckDelay time.Duration
+
+	// Elements, if set, produces the partitioned elements served for an input file once its job completes.
+	// By default, each non-empty paragraph of a UTF-8 input becomes a NarrativeText element.
+	Elements func(filename string, content []byte) []map[string]any
+
+	mu  sync.Mutex
+	now time.Time
+	mux *http.ServeMux
+
+	sources      map[string]*fakeConnector
+	destinations map[string]*fakeConnector
+	workflows    map[string]*fakeWorkflow
+	jobs         map[string]*fakeJob
+
+	failChecks map[string]string
+	failFiles  map[string]string
+}
+
+type fakeConnector struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	Type      string          `json:"type"`
+	Config    json.RawMessage `json:"config"`
+	CreatedAt string          `json:"created_at"`
+	UpdatedAt string          `json:"updated_at,omitempty"`
+
+	check *fakeCheck
+}
+
+// maskedSecret replaces credentials in the connectors the API reads back.
+const maskedSecret = "**********"
+
+// masked returns a copy of the connector whose credentials are masked, like the API does on get and list.
+func (c *fakeConnector) masked() *fakeConnector {
+	var config map[string]any
+	if err := json.Unmarshal(c.Config, &config); err != nil {
+		return c
+	}
+
+	for k, v := range config {
+		if v != nil && slices.Contains(secret.Fields, k) {
+			config[k] = maskedSecret
+		}
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return c
+	}
+
+	out := *c
+	out.Config = data
+
+	return &out
+}
+
+type fakeCheck struct {
+	id      string
+	created time.Time
+}
+
+type fakeNode struct {
+	ID       string          `json:"id"`
+	Name     string          `json:"name"`
+	Type     string          `json:"type"`
+	Subtype  string          `json:"subtype"`
+	Settings json.RawMessage `json:"settings"`
+}
+
+type fakeWorkflow struct {
+	ID            string        `json:"id"`
+	Name          string        `json:"name"`
+	Sources       []string      `json:"sources"`
+	Destinations  []string      `json:"destinations"`
+	WorkflowType  *string       `json:"workflow_type"`
+	WorkflowNodes []fakeNode    `json:"workflow_nodes"`
+	Schedule      *fakeSchedule `json:"schedule"`
+	Status        string        `json:"status"`
+	CreatedAt     string        `json:"created_at"`
+	UpdatedAt     string        `json:"updated_at,omitempty"`
+	ReprocessAll  *bool         `json:"reprocess_all"`
+}
+
+type fakeSchedule struct {
+	CronTabEntries []fakeCronTabEntry `json:"crontab_entries"`
+}
+
+type fakeCronTabEntry struct {
+	CronExpression string `json:"cron_expression"`
+}
+
+type fakeFile struct {
+	id      string
+	name    string
+	content []byte
+	failure string
+}
+
+type fakeJob struct {
+	id       string
+	workflow *fakeWorkflow
+	created  time.Time
+	stopped  *time.Time
+	files    []*fakeFile
+	outputs  map[string]*fakeFile
+}
+
+type fakeNodeFile struct {
+	NodeID string `json:"node_id"`
+	FileID string `json:"file_id"`
+}
+
+// NewFakeServer returns an empty FakeServer that requires [FakeAPIKey].
+func NewFakeServer() *FakeServer {
+	f := &FakeServer{
+		APIKey:        FakeAPIKey,
+		ScheduleDelay: 5 * time.Second,
+		RunDuration:   30 * time.Second,
+		CheckDelay:    2 * time.Second,
+		now:           time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		mux:           http.NewServeMux(),
+		sources:       make(map[string]*fakeConnector),
+		destinations:  make(map[string]*fakeConnector),
+		workflows:     make(map[string]*fakeWorkflow),
+		jobs:          make(map[string]*fakeJob),
+		failChecks:    make(map[string]string),
+		failFiles:     make(map[string]string),
+	}
+
+	f.mux.HandleFunc("GET /sources", f.listConnectors(f.sources, "source_type"))
+	f.mux.HandleFunc("POST /sources", f.createConnector(f.sources))
+	f.mux.HandleFunc("GET /sources/{id}", f.getConnector(f.sources, "Source"))
+	f.mux.HandleFunc("PUT /sources/{id}", f.updateConnector(f.sources, "Source"))
+	f.mux.HandleFunc("DELETE /sources/{id}", f.deleteConnector(f.sources, "Source"))
+	f.mux.HandleFunc("POST /sources/{id}/connection-check", f.createCheck(f.sources, "Source"))
+	f.mux.HandleFunc("GET /sources/{id}/connection-check", f.getCheck(f.sources, "Source"))
+
+	f.mux.HandleFunc("GET /destinations", f.listConnectors(f.destinations, "destination_type"))
+	f.mux.HandleFunc("POST /destinations", f.createConnector(f.destinations))
+	f.mux.HandleFunc("GET /destinations/{id}", f.getConnector(f.destinations, "Destination"))
+	f.mux.HandleFunc("PUT /destinations/{id}", f.updateConnector(f.destinations, "Destination"))
+	f.mux.HandleFunc("DELETE /destinations/{id}", f.deleteConnector(f.destinations, "Destination"))
+	f.mux.HandleFunc("POST /destinations/{id}/connection-check", f.createCheck(f.destinations, "Destination"))
+	f.mux.HandleFunc("GET /destinations/{id}/connection-check", f.getCheck(f.destinations, "Destination"))
+
+	f.mux.HandleFunc("GET /workflows", f.listWorkflows)
+	f.mux.HandleFunc("POST /workflows", f.createWorkflow)
+	f.mux.HandleFunc("GET /workflows/{id}", f.getWorkflow)
+	f.mux.HandleFunc("PUT /workflows/{id}", f.updateWorkflow)
+	f.mux.HandleFunc("DELETE /workflows/{id}", f.deleteWorkflow)
+	f.mux.HandleFunc("POST /workflows/{id}/run", f.runWorkflow)
+
+	f.mux.HandleFunc("GET /jobs", f.listJobs)
+	f.mux.HandleFunc("GET /jobs/{id}", f.getJob)
+	f.mux.HandleFunc("POST /jobs/{id}/cancel", f.cancelJob)
+	f.mux.HandleFunc("GET /jobs/{id}/details", f.getJobDetails)
+	f.mux.HandleFunc("GET /jobs/{id}/failed-files", f.getJobFailedFiles)
+	f.mux.HandleFunc("GET /jobs/{id}/download", f.downloadJob)
+
+	return f
+}
+
+func (f *FakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-Request-Id", newID())
+
+	if f.APIKey != "" && r.Header.Get("Unstructured-API-Key") != f.APIKey {
+		writeJSON(w, http.StatusUnauthorized, map[string]any{"detail": "Invalid API key"})
+		return
+	}
+
+	r.URL.Path = strings.TrimPrefix(r.URL.Path, "/api/v1")
+	if r.URL.Path != "/" {
+		r.URL.Path = strings.TrimSuffix(r.URL.Path, "/")
+	}
+
+	r.URL.RawPath = ""
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.mux.ServeHTTP(w, r)
+}
+
+// Now returns the current time on the server's clock.
+func (f *FakeServer) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+// Advance moves the server's clock forward by d, progressing any scheduled jobs and connection checks.
+func (f *FakeServer) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+}
+
+// FailConnectionCheck makes every connection check for the connector with the given ID fail with reason.
+func (f *FakeServer) FailConnectionCheck(id, reason string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.failChecks[id] = reason
+}
+
+// FailFile makes every input file with the given name fail processing with the given error message.
+// A job whose input files all fail finishes as FAILED. An empty message makes the file succeed again.
+func (f *FakeServer) FailFile(name, message string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.failFiles[name] = message
+}
+
+// Connectors.
+
+func (f *FakeServer) listConnectors(store map[string]*fakeConnector, typeParam string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		typ := r.URL.Query().Get(typeParam)
+
+		out := make([]*fakeConnector, 0, len(store))
+
+		for _, c := range store {
+			if typ == "" || c.Type == typ {
+				out = append(out, c.masked())
+			}
+		}
+
+		slices.SortFunc(out, func(a, b *fakeConnector) int { return cmp.Compare(a.CreatedAt+a.ID, b.CreatedAt+b.ID) })
+
+		writeJSON(w, http.StatusOK, out)
+	}
+}
+
+func (f *FakeServer) createConnector(store map[string]*fakeConnector) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var in struct {
+			Name   *string         `json:"name"`
+			Type   *string         `json:"type"`
+			Config json.RawMessage `json:"config"`
+		}
+
+		if !decodeBody(w, r, &in) {
+			return
+		}
+
+		var errs validationErrors
+		errs.require(in.Name != nil && *in.Name != "", "body", "name")
+		errs.require(in.Type != nil && *in.Type != "", "body", "type")
+		errs.requireObject(in.Config, "body", "config")
+
+		if errs.write(w) {
+			return
+		}
+
+		c := &fakeConnector{
+			ID:        newID(),
+			Name:      *in.Name,
+			Type:      *in.Type,
+			Config:    in.Config,
+			CreatedAt: f.timestamp(f.now),
+		}
+		store[c.ID] = c
+
+		writeJSON(w, http.StatusOK, c)
+	}
+}
+
+func (f *FakeServer) getConnector(store map[string]*fakeConnector, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c, ok := store[r.PathValue("id")]
+		if !ok {
+			notFound(w, kind, r.PathValue("id"))
+			return
+		}
+
+		writeJSON(w, http.StatusOK, c.masked())
+	}
+}
+
+func (f *FakeServer) updateConnector(store map[string]*fakeConnector, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c, ok := store[r.PathValue("id")]
+		if !ok {
+			notFound(w, kind, r.PathValue("id"))
+			return
+		}
+
+		// connectors cannot be renamed, so the update has only a config.
+		var in struct {
+			Config json.RawMessage `json:"config"`
+		}
+
+		if !decodeStrictBody(w, r, &in) {
+			return
+		}
+
+		var errs validationErrors
+		errs.requireObject(in.Config, "body", "config")
+
+		if errs.write(w) {
+			return
+		}
+
+		c.Config = in.Config
+		c.UpdatedAt = f.timestamp(f.now)
+
+		writeJSON(w, http.StatusOK, c)
+	}
+}
+
+func (f *FakeServer) deleteConnector(store map[string]*fakeConnector, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := store[id]; !ok {
+			notFound(w, kind, id)
+			return
+		}
+
+		delete(store, id)
+
+		writeJSON(w, http.StatusOK, map[string]any{"detail": kind + " with id " + id + " successfully deleted."})
+	}
+}
+
+func (f *FakeServer) createCheck(store map[string]*fakeConnector, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c, ok := store[r.PathValue("id")]
+		if !ok {
+			notFound(w, kind, r.PathValue("id"))
+			return
+		}
+
+		c.check = &fakeCheck{id: newID(), created: f.now}
+
+		writeJSON(w, http.StatusOK, f.checkStatus(c))
+	}
+}
+
+func (f *FakeServer) getCheck(store map[string]*fakeConnector, kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c, ok := store[r.PathValue("id")]
+		if !ok {
+			notFound(w, kind, r.PathValue("id"))
+			return
+		}
+
+		if c.check == nil {
+			writeJSON(w, http.StatusNotFound, map[string]any{"detail": "No connection check found for " + kind + " " + c.ID})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, f.checkStatus(c))
+	}
+}
+
+func (f *FakeServer) checkStatus(c *fakeConnector) map[string]any {
+	out := map[string]any{
+		"id":          c.check.id,
+		"status":      "SCHEDULED",
+		"reason":      nil,
+		"created_at":  f.timestamp(c.check.created),
+		"reported_at": nil,
+	}
+
+	reported := c.check.created.Add(f.CheckDelay)
+	if f.now.Before(reported) {
+		return out
+	}
+
+	out["status"] = "SUCCESS"
+	out["reported_at"] = f.timestamp(reported)
+
+	if reason, ok := f.failChecks[c.ID]; ok {
+		out["status"] = "FAILURE"
+		out["reason"] = reason
+	}
+
+	return out
+}
+
+// Workflows.
+
+func (f *FakeServer) listWorkflows(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	var errs validationErrors
+
+	page, pageSize := errs.intParam(q.Get("page"), 1, "query", "page"), errs.intParam(q.Get("page_size"), 20, "query", "page_size")
+	if errs.write(w) {
+		return
+	}
+
+	out := make([]*fakeWorkflow, 0, len(f.workflows))
+
+	for _, wf := range f.workflows {
+		switch {
+		case q.Has("source_id") && !slices.Contains(wf.Sources, q.Get("source_id")),
+			q.Has("destination_id") && !slices.Contains(wf.Destinations, q.Get("destination_id")),
+			q.Has("status") && wf.Status != q.Get("status"),
+			q.Has("name") && !strings.Contains(wf.Name, q.Get("name")):
+			continue
+		}
+
+		out = append(out, wf)
+	}
+
+	slices.SortFunc(out, func(a, b *fakeWorkflow) int { return cmp.Compare(a.CreatedAt+a.ID, b.CreatedAt+b.ID) })
+
+	if q.Get("sort_direction") == "desc" {
+		slices.Reverse(out)
+	}
+
+	writeJSON(w, http.StatusOK, paginate(out, page, pageSize))
+}
+
+type fakeWorkflowRequest struct {
+	Name          *string        `json:"name"`
+	SourceID      *string        `json:"source_id"`
+	DestinationID *string        `json:"destination_id"`
+	WorkflowType  *string        `json:"workflow_type"`
+	WorkflowNodes *[]fakeNode    `json:"workflow_nodes"`
+	Schedule      *string        `json:"schedule"`
+	ReprocessAll  *bool          `json:"reprocess_all"`
+	Raw           map[string]any `json:"-"`
+}
+
+func (f *FakeServer) decodeWorkflow(w http.ResponseWriter, r *http.Request, create bool) (*fakeWorkflowRequest, bool) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"detail": err.Error()})
+		return nil, false
+	}
+
+	var in fakeWorkflowRequest
+
+	var errs validationErrors
+
+	if err := json.Unmarshal(data, &in); err != nil {
+		errs.add("json_invalid", "JSON decode error: "+err.Error(), "body")
+		errs.write(w)
+
+		return nil, false
+	}
+
+	_ = json.Unmarshal(data, &in.Raw)
+
+	if create {
+		errs.require(in.Name != nil && *in.Name != "", "body", "name")
+	}
+
+	if in.SourceID != nil {
+		if _, ok := f.sources[*in.SourceID]; !ok {
+			errs.add("value_error", "Source with id "+*in.SourceID+" not found", "body", "source_id")
+		}
+	}
+
+	if in.DestinationID != nil {
+		if _, ok := f.destinations[*in.DestinationID]; !ok {
+			errs.add("value_error", "Destination with id "+*in.DestinationID+" not found", "body", "destination_id")
+		}
+	}
+
+	if in.Schedule != nil {
+		if _, ok := fakeSchedules[*in.Schedule]; !ok {
+			errs.add("enum", "Input should be one of the supported schedules", "body", "schedule")
+		}
+	}
+
+	if in.WorkflowNodes != nil {
+		for i, node := range *in.WorkflowNodes {
+			errs.require(node.Name != "", "body", "workflow_nodes", i, "name")
+			errs.require(node.Type != "", "body", "workflow_nodes", i, "type")
+			errs.require(node.Subtype != "", "body", "workflow_nodes", i, "subtype")
+		}
+	}
+
+	if errs.write(w) {
+		return nil, false
+	}
+
+	return &in, true
+}
+
+// fakeSchedules maps the schedule presets accepted by the API to the cron expressions the API reports back.
+var fakeSchedules = map[string]string{
+	"every 15 minutes": "*/15 * * * *",
+	"every hour":       "0 * * * *",
+	"every 2 hours":    "0 */2 * * *",
+	"every 4 hours":    "0 */4 * * *",
+	"every 6 hours":    "0 */6 * * *",
+	"every 8 hours":    "0 */8 * * *",
+	"every 10 hours":   "0 */10 * * *",
+	"every 12 hours":   "0 */12 * * *",
+	"daily":            "0 0 * * *",
+	"weekly":           "0 0 * * 0",
+	"monthly":          "0 0 1 * *",
+}
+
+func (f *FakeServer) applyWorkflow(wf *fakeWorkflow, in *fakeWorkflowRequest) {
+	if in.Name != nil {
+		wf.Name = *in.Name
+	}
+
+	if _, ok := in.Raw["source_id"]; ok {
+		wf.Sources = []string{}
+		if in.SourceID != nil {
+			wf.Sources = []string{*in.SourceID}
+		}
+	}
+
+	if _, ok := in.Raw["destination_id"]; ok {
+		wf.Destinations = []string{}
+		if in.DestinationID != nil {
+			wf.Destinations = []string{*in.DestinationID}
+		}
+	}
+
+	if in.WorkflowType != nil {
+		wf.WorkflowType = in.WorkflowType
+	}
+
+	if in.WorkflowNodes != nil {
+		wf.WorkflowNodes = *in.WorkflowNodes
+		for i := range wf.WorkflowNodes {
+			if wf.WorkflowNodes[i].ID == "" {
+				wf.WorkflowNodes[i].ID = newID()
+			}
+		}
+	}
+
+	if in.Schedule != nil {
+		wf.Schedule = &fakeSchedule{CronTabEntries: []fakeCronTabEntry{{CronExpression: fakeSchedules[*in.Schedule]}}}
+	}
+
+	if in.ReprocessAll != nil {
+		wf.ReprocessAll = in.ReprocessAll
+	}
+
+	if len(wf.Sources) > 0 && len(wf.Destinations) > 0 {
+		wf.Status = "active"
+	} else {
+		wf.Status = "inactive"
+	}
+}
+
+func (f *FakeServer) createWorkflow(w http.ResponseWriter, r *http.Request) {
+	in, ok := f.decodeWorkflow(w, r, true)
+	if !ok {
+		return
+	}
+
+	wf := &fakeWorkflow{
+		ID:            newID(),
+		Sources:       []string{},
+		Destinations:  []string{},
+		WorkflowNodes: []fakeNode{},
+		CreatedAt:     f.timestamp(f.now),
+	}
+	f.applyWorkflow(wf, in)
+	f.workflows[wf.ID] = wf
+
+	writeJSON(w, http.StatusOK, wf)
+}
+
+func (f *FakeServer) getWorkflow(w http.ResponseWriter, r *http.Request) {
+	wf, ok := f.workflows[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Workflow", r.PathValue("id"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, wf)
+}
+
+func (f *FakeServer) updateWorkflow(w http.ResponseWriter, r *http.Request) {
+	wf, ok := f.workflows[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Workflow", r.PathValue("id"))
+		return
+	}
+
+	in, ok := f.decodeWorkflow(w, r, false)
+	if !ok {
+		return
+	}
+
+	f.applyWorkflow(wf, in)
+	wf.UpdatedAt = f.timestamp(f.now)
+
+	writeJSON(w, http.StatusOK, wf)
+}
+
+func (f *FakeServer) deleteWorkflow(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := f.workflows[id]; !ok {
+		notFound(w, "Workflow", id)
+		return
+	}
+
+	delete(f.workflows, id)
+
+	writeJSON(w, http.StatusOK, map[string]any{"detail": "Workflow with id " + id + " successfully deleted."})
+}
+
+func (f *FakeServer) runWorkflow(w http.ResponseWriter, r *http.Request) {
+	wf, ok := f.workflows[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Workflow", r.PathValue("id"))
+		return
+	}
+
+	job := &fakeJob{
+		id:       newID(),
+		workflow: wf,
+		created:  f.now,
+		outputs:  make(map[string]*fakeFile),
+	}
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			var errs validationErrors
+			errs.add("value_error", "invalid multipart body: "+err.Error(), "body", "input_files")
+			errs.write(w)
+
+			return
+		}
+
+		for _, fh := range r.MultipartForm.File["input_files"] {
+			file, err := fh.Open()
+			if err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"detail": err.Error()})
+				return
+			}
+
+			content, err := io.ReadAll(file)
+			_ = file.Close()
+
+			if err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"detail": err.Error()})
+				return
+			}
+
+			job.files = append(job.files, &fakeFile{
+				id:      newID(),
+				name:    fh.Filename,
+				content: content,
+				failure: f.failFiles[fh.Filename],
+			})
+		}
+	} else if len(wf.Sources) == 0 {
+		var errs validationErrors
+		errs.add("value_error", "Workflow has no source connector and no input files were provided", "body", "input_files")
+		errs.write(w)
+
+		return
+	}
+
+	for _, file := range job.files {
+		if file.failure == "" {
+			job.outputs[newID()] = file
+		}
+	}
+
+	f.jobs[job.id] = job
+
+	writeJSON(w, http.StatusAccepted, f.jobInfo(job))
+}
+
+// Jobs.
+
+func (f *FakeServer) jobStatus(job *fakeJob) string {
+	if job.stopped != nil {
+		return "STOPPED"
+	}
+
+	started := job.created.Add(f.ScheduleDelay)
+	finished := started.Add(f.RunDuration)
+
+	switch {
+	case f.now.Before(started):
+		return "SCHEDULED"
+	case f.now.Before(finished):
+		return "IN_PROGRESS"
+	case len(job.files) > 0 && len(job.outputs) == 0:
+		return "FAILED"
+	default:
+		return "COMPLETED"
+	}
+}
+
+func (f *FakeServer) jobInfo(job *fakeJob) map[string]any {
+	status := f.jobStatus(job)
+
+	out := map[string]any{
+		"id":            job.id,
+		"workflow_id":   job.workflow.ID,
+		"workflow_name": job.workflow.Name,
+		"status":        status,
+		"created_at":    f.timestamp(job.created),
+		"runtime":       nil,
+		"job_type":      "persistent",
+	}
+
+	if len(job.files) > 0 {
+		ids := make([]string, len(job.files))
+		for i, file := range job.files {
+			ids[i] = file.id
+		}
+
+		out["input_file_ids"] = ids
+		out["job_type"] = "ephemeral"
+	}
+
+	switch status {
+	case "COMPLETED", "FAILED":
+		out["runtime"] = fmt.Sprintf("PT%gS", (f.ScheduleDelay + f.RunDuration).Seconds())
+	case "STOPPED":
+		out["runtime"] = fmt.Sprintf("PT%gS", job.stopped.Sub(job.created).Seconds())
+	}
+
+	if status == "COMPLETED" && len(job.workflow.WorkflowNodes) > 0 {
+		last := job.workflow.WorkflowNodes[len(job.workflow.WorkflowNodes)-1]
+
+		files := make([]fakeNodeFile, 0, len(job.outputs))
+		for id := range job.outputs {
+			files = append(files, fakeNodeFile{NodeID: last.ID, FileID: id})
+		}
+
+		slices.SortFunc(files, func(a, b fakeNodeFile) int { return cmp.Compare(job.outputs[a.FileID].id, job.outputs[b.FileID].id) })
+
+		out["output_node_files"] = files
+	}
+
+	return out
+}
+
+func (f *FakeServer) listJobs(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	out := make([]map[string]any, 0, len(f.jobs))
+
+	jobs := make([]*fakeJob, 0, len(f.jobs))
+	for _, job := range f.jobs {
+		jobs = append(jobs, job)
+	}
+
+	slices.SortFunc(jobs, func(a, b *fakeJob) int {
+		return cmp.Or(a.created.Compare(b.created), cmp.Compare(a.id, b.id))
+	})
+
+	for _, job := range jobs {
+		if q.Has("workflow_id") && job.workflow.ID != q.Get("workflow_id") {
+			continue
+		}
+
+		if q.Has("status") && f.jobStatus(job) != q.Get("status") {
+			continue
+		}
+
+		out = append(out, f.jobInfo(job))
+	}
+
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (f *FakeServer) getJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := f.jobs[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Job", r.PathValue("id"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, f.jobInfo(job))
+}
+
+func (f *FakeServer) cancelJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := f.jobs[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Job", r.PathValue("id"))
+		return
+	}
+
+	switch f.jobStatus(job) {
+	case "SCHEDULED", "IN_PROGRESS":
+		now := f.now
+		job.stopped = &now
+	default:
+		var errs validationErrors
+		errs.add("value_error", "Job "+job.id+" is not running", "path", "job_id")
+		errs.write(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"detail": "Job " + job.id + " cancelled"})
+}
+
+func (f *FakeServer) getJobDetails(w http.ResponseWriter, r *http.Request) {
+	job, ok := f.jobs[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Job", r.PathValue("id"))
+		return
+	}
+
+	status := f.jobStatus(job)
+	total := max(len(job.files), 1)
+	failed := len(job.files) - len(job.outputs)
+
+	processing := map[string]string{
+		"SCHEDULED":   "SCHEDULED",
+		"IN_PROGRESS": "IN_PROGRESS",
+		"COMPLETED":   "SUCCESS",
+		"FAILED":      "FAILED",
+		"STOPPED":     "STOPPED",
+	}[status]
+
+	if status == "COMPLETED" && failed > 0 {
+		processing = "COMPLETED_WITH_ERRORS"
+	}
+
+	stats := make([]map[string]any, len(job.workflow.WorkflowNodes))
+
+	for i, node := range job.workflow.WorkflowNodes {
+		s := map[string]any{
+			"node_name":    node.Name,
+			"node_type":    node.Type,
+			"node_subtype": node.Subtype,
+			"ready":        0,
+			"in_progress":  0,
+			"success":      0,
+			"failure":      0,
+		}
+
+		switch status {
+		case "SCHEDULED":
+			s["ready"] = total
+		case "IN_PROGRESS", "STOPPED":
+			s["in_progress"] = total
+		default:
+			// files fail in the first node and never reach the rest.
+			s["success"] = total - failed
+			if i == 0 {
+				s["failure"] = failed
+			}
+		}
+
+		stats[i] = s
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"id":                job.id,
+		"processing_status": processing,
+		"node_stats":        stats,
+		"message":           nil,
+	})
+}
+
+func (f *FakeServer) getJobFailedFiles(w http.ResponseWriter, r *http.Request) {
+	job, ok := f.jobs[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Job", r.PathValue("id"))
+		return
+	}
+
+	failed := []map[string]any{}
+
+	if status := f.jobStatus(job); status == "COMPLETED" || status == "FAILED" {
+		for _, file := range job.files {
+			if file.failure != "" {
+				failed = append(failed, map[string]any{"document": file.name, "error": file.failure})
+			}
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"failed_files": failed})
+}
+
+func (f *FakeServer) downloadJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := f.jobs[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Job", r.PathValue("id"))
+		return
+	}
+
+	q := r.URL.Query()
+
+	var errs validationErrors
+	errs.require(q.Get("node_id") != "", "query", "node_id")
+	errs.require(q.Get("file_id") != "", "query", "file_id")
+
+	if errs.write(w) {
+		return
+	}
+
+	if status := f.jobStatus(job); status != "COMPLETED" {
+		writeJSON(w, http.StatusConflict, map[string]any{"detail": "Job " + job.id + " is " + status + ", output is not available"})
+		return
+	}
+
+	file, ok := job.outputs[q.Get("file_id")]
+	if !ok || len(job.workflow.WorkflowNodes) == 0 || job.workflow.WorkflowNodes[len(job.workflow.WorkflowNodes)-1].ID != q.Get("node_id") {
+		writeJSON(w, http.StatusNotFound, map[string]any{"detail": "Output file not found"})
+		return
+	}
+
+	elements := f.Elements
+	if elements == nil {
+		elements = defaultElements
+	}
+
+	writeJSON(w, http.StatusOK, elements(file.name, file.content))
+}
+
+func defaultElements(filename string, content []byte) []map[string]any {
+	elements := []map[string]any{}
+
+	if !utf8.Valid(content) {
+		return elements
+	}
+
+	for _, para := range strings.Split(string(content), "\n\n") {
+		para = strings.TrimSpace(para)
+		if para == "" {
+			continue
+		}
+
+		elements = append(elements, map[string]any{
+			"type":       "NarrativeText",
+			"element_id": newID(),
+			"text":       para,
+			"metadata": map[string]any{
+				"filename":    filename,
+				"page_number": 1,
+				"languages":   []string{"eng"},
+			},
+		})
+	}
+
+	return elements
+}
+
+// Helpers.
+
+func (f *FakeServer) timestamp(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+func newID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+func paginate[T any](items []T, page, size int) []T {
+	if size <= 0 {
+		return items
+	}
+
+	page = max(page, 1)
+
+	start := min((page-1)*size, len(items))
+	end := min(start+size, len(items))
+
+	return items[start:end]
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(code)
+	_, _ = w.Write(buf.Bytes())
+}
+
+func notFound(w http.ResponseWriter, kind, id string) {
+	writeJSON(w, http.StatusNotFound, map[string]any{"detail": kind + " with id " + id + " not found"})
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		var errs validationErrors
+		errs.add("json_invalid", "JSON decode error: "+err.Error(), "body")
+		errs.write(w)
+
+		return false
+	}
+
+	return true
+}
+
+// decodeStrictBody is like decodeBody, but rejects fields that the request schema does not have.
+func decodeStrictBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(v); err != nil {
+		var errs validationErrors
+
+		if _, field, ok := strings.Cut(err.Error(), "unknown field "); ok {
+			errs.add("extra_forbidden", "Extra inputs are not permitted", "body", strings.Trim(field, `"`))
+		} else {
+			errs.add("json_invalid", "JSON decode error: "+err.Error(), "body")
+		}
+
+		errs.write(w)
+
+		return false
+	}
+
+	return true
+}
+
+// validationErrors accumulates entries for a 422 HTTPValidationError response.
+type validationErrors []map[string]any
+
+func (v *validationErrors) add(typ, msg string, loc ...any) {
+	*v = append(*v, map[string]any{"type": typ, "loc": loc, "msg": msg})
+}
+
+func (v *validationErrors) require(ok bool, loc ...any) {
+	if !ok {
+		v.add("missing", "Field required", loc...)
+	}
+}
+
+func (v *validationErrors) requireObject(data json.RawMessage, loc ...any) {
+	if len(data) == 0 || string(data) == "null" {
+		v.add("missing", "Field required", loc...)
+		return
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		v.add("dict_type", "Input should be a valid dictionary", loc...)
+	}
+}
+
+func (v *validationErrors) intParam(s string, def int, loc ...any) int {
+	if s == "" {
+		return def
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		v.add("int_parsing", "Input should be a valid integer, unable to parse string as an integer", loc...)
+		return def
+	}
+
+	return n
+}
+
+func (v validationErrors) write(w http.ResponseWriter) bool {
+	if len(v) == 0 {
+		return false
+	}
+
+	writeJSON(w, http.StatusUnprocessableEntity, map[string]any{"detail": v})
+
+	return true
+}
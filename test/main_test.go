@@ -23,10 +23,6 @@ func TestWorkflow(t *testing.T) {
 	t.Parallel()
 	t.Skip()
 
-	if os.Getenv("UNSTRUCTURED_API_KEY") == "" {
-		t.Skip("skipping because UNSTRUCTURED_API_KEY is not set")
-	}
-
 	pretty := func(v any) string {
 		data, err := json.MarshalIndent(v, "", "  ")
 		if err != nil {
@@ -36,10 +32,7 @@ func TestWorkflow(t *testing.T) {
 		return string(data)
 	}
 
-	client, err := unstructured.New()
-	if err != nil {
-		t.Fatalf("failed to create client: %v", err)
-	}
+	client := testClient(t, "workflow")
 
 	ctx := testContext(t)
 
@@ -0,0 +1,406 @@
+package test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/aws-gopher/unstructured-sdk-go/internal/secret"
+)
+
+// RecorderMode controls whether a [Recorder] talks to the real API or replays a cassette.
+type RecorderMode int
+
+const (
+	// ModeAuto replays the cassette if it exists and records a new one otherwise.
+	ModeAuto RecorderMode = iota
+	// ModeReplay only replays the cassette and never sends requests upstream.
+	ModeReplay
+	// ModeRecord sends every request upstream and overwrites the cassette with the traffic.
+	ModeRecord
+)
+
+// Redacted replaces secrets in recorded traffic.
+const Redacted = "REDACTED"
+
+// DefaultSecretFields are the JSON object keys whose values are scrubbed from recorded bodies.
+// They cover the credential fields of the connector configs and workflow node settings,
+// and are the same fields the client redacts from its logs.
+var DefaultSecretFields = slices.Clone(secret.Fields)
+
+// DefaultSecretHeaders are the headers whose values are scrubbed from recorded requests and responses.
+var DefaultSecretHeaders = []string{
+	"Unstructured-API-Key",
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// Match selects which parts of a request must be equal for a recorded interaction to be replayed.
+type Match struct {
+	Method bool
+	Path   bool
+	Query  bool
+	// Body compares request bodies after normalization: JSON bodies are compared structurally
+	// with secrets scrubbed, and multipart bodies ignore the random boundary.
+	Body bool
+}
+
+// MatchAll matches on method, path, query and normalized body.
+var MatchAll = Match{Method: true, Path: true, Query: true, Body: true}
+
+// Interaction is a single recorded request and response, stored as one line of a JSONL cassette.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the request half of an [Interaction].
+type RecordedRequest struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 string      `json:"body_base64,omitempty"`
+}
+
+// RecordedResponse is the response half of an [Interaction].
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 string      `json:"body_base64,omitempty"`
+}
+
+// Recorder is an [http.RoundTripper] that records traffic to a JSONL cassette and replays it later,
+// so that integration tests can run offline against real, previously recorded responses.
+//
+//	rec, err := test.NewRecorder("testdata/workflow.jsonl", test.ModeAuto, nil)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	t.Cleanup(func() { _ = rec.Close() })
+//
+//	client, err := unstructured.New(unstructured.WithClient(&http.Client{Transport: rec}))
+//
+// API keys and credential fields are scrubbed before anything is written to the cassette.
+type Recorder struct {
+	// Match selects how requests are matched against the cassette when replaying.
+	Match Match
+
+	// SecretFields lists JSON object keys whose values are scrubbed. It defaults to [DefaultSecretFields].
+	SecretFields []string
+
+	// SecretHeaders lists headers whose values are scrubbed. It defaults to [DefaultSecretHeaders].
+	SecretHeaders []string
+
+	mode     RecorderMode
+	upstream http.RoundTripper
+
+	mu           sync.Mutex
+	file         *os.File
+	interactions []Interaction
+	used         []bool
+}
+
+// NewRecorder returns a Recorder for the cassette at path.
+// In [ModeRecord], and in [ModeAuto] when the cassette does not exist, requests are sent through upstream,
+// which defaults to [http.DefaultTransport], and each interaction is appended to the cassette.
+// Otherwise the cassette is loaded and requests are answered from it.
+func NewRecorder(path string, mode RecorderMode, upstream http.RoundTripper) (*Recorder, error) {
+	r := &Recorder{
+		Match:         MatchAll,
+		SecretFields:  DefaultSecretFields,
+		SecretHeaders: DefaultSecretHeaders,
+		mode:          mode,
+		upstream:      upstream,
+	}
+
+	if r.upstream == nil {
+		r.upstream = http.DefaultTransport
+	}
+
+	if mode == ModeAuto {
+		r.mode = ModeReplay
+
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			r.mode = ModeRecord
+		}
+	}
+
+	if r.mode == ModeRecord {
+		f, err := os.Create(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cassette: %w", err)
+		}
+
+		r.file = f
+
+		return r, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cassette: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var in Interaction
+		if err := json.Unmarshal(scanner.Bytes(), &in); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette line %d: %w", len(r.interactions)+1, err)
+		}
+
+		r.interactions = append(r.interactions, in)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	r.used = make([]bool, len(r.interactions))
+
+	return r, nil
+}
+
+// Recording reports whether the recorder is sending requests upstream rather than replaying them.
+func (r *Recorder) Recording() bool { return r.mode == ModeRecord }
+
+// Close closes the cassette file when recording.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.file.Close()
+	r.file = nil
+
+	return err //nolint:wrapcheck
+}
+
+// RoundTrip implements the [http.RoundTripper] interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+
+	return r.replay(req, body)
+}
+
+func (r *Recorder) record(req *http.Request, reqBody []byte) (*http.Response, error) {
+	resp, err := r.upstream.RoundTrip(req)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	in := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: r.scrubHeader(req.Header),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     r.scrubHeader(resp.Header),
+		},
+	}
+
+	in.Request.Body, in.Request.BodyBase64 = recordBody(r.scrubBody(reqBody))
+	in.Response.Body, in.Response.BodyBase64 = recordBody(r.scrubBody(respBody))
+
+	line, err := json.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal interaction: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil, errors.New("recorder is closed")
+	}
+
+	if _, err := r.file.Write(append(line, '\n')); err != nil {
+		return nil, fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	want := r.normalizeBody(req.Header.Get("Content-Type"), body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, in := range r.interactions {
+		if r.used[i] || !r.matches(req, want, in.Request) {
+			continue
+		}
+
+		r.used[i] = true
+
+		respBody, err := recordedBody(in.Response.Body, in.Response.BodyBase64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode recorded response body: %w", err)
+		}
+
+		header := in.Response.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(respBody)),
+			ContentLength: int64(len(respBody)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction matches %s %s", req.Method, req.URL)
+}
+
+func (r *Recorder) matches(req *http.Request, body string, rec RecordedRequest) bool {
+	u, err := url.Parse(rec.URL)
+	if err != nil {
+		return false
+	}
+
+	if r.Match.Method && req.Method != rec.Method {
+		return false
+	}
+
+	if r.Match.Path && strings.TrimSuffix(req.URL.Path, "/") != strings.TrimSuffix(u.Path, "/") {
+		return false
+	}
+
+	if r.Match.Query && req.URL.Query().Encode() != u.Query().Encode() {
+		return false
+	}
+
+	if r.Match.Body {
+		recorded, err := recordedBody(rec.Body, rec.BodyBase64)
+		if err != nil {
+			return false
+		}
+
+		if body != r.normalizeBody(rec.Header.Get("Content-Type"), recorded) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeBody returns a canonical form of a request body for matching.
+func (r *Recorder) normalizeBody(contentType string, body []byte) string {
+	body = r.scrubBody(body)
+
+	var v any
+	if err := json.Unmarshal(body, &v); err == nil {
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+
+	if _, params, err := mime.ParseMediaType(contentType); err == nil && params["boundary"] != "" {
+		return strings.ReplaceAll(string(body), params["boundary"], "BOUNDARY")
+	}
+
+	return string(body)
+}
+
+func (r *Recorder) scrubHeader(h http.Header) http.Header {
+	out := h.Clone()
+
+	for _, key := range r.SecretHeaders {
+		if out.Get(key) != "" {
+			out.Set(key, Redacted)
+		}
+	}
+
+	return out
+}
+
+// scrubBody replaces the values of secret fields in a JSON body. Non-JSON bodies are returned unchanged.
+func (r *Recorder) scrubBody(body []byte) []byte {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+
+	data, err := json.Marshal(secret.Redact(v, r.SecretFields, Redacted))
+	if err != nil {
+		return body
+	}
+
+	return data
+}
+
+func recordBody(body []byte) (text, b64 string) {
+	if len(body) == 0 {
+		return "", ""
+	}
+
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+
+	return "", base64.StdEncoding.EncodeToString(body)
+}
+
+func recordedBody(text, b64 string) ([]byte, error) {
+	if b64 != "" {
+		return base64.StdEncoding.DecodeString(b64) //nolint:wrapcheck
+	}
+
+	return []byte(text), nil
+}
@@ -0,0 +1,192 @@
+package unstructured
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FieldError is a validation error located at a field of the Go request that caused it.
+type FieldError struct {
+	// Field is the Go path of the invalid field, starting at the request type,
+	// like "CreateWorkflowRequest.WorkflowNodes[2].(*ChunkerCharacter).MaxCharacters".
+	// If the location does not map onto the request, Field is the location as reported by the API,
+	// like "body.config.batch_size".
+	Field string
+
+	// Resolved reports whether Field is a Go path.
+	Resolved bool
+
+	Message string
+	Type    string
+}
+
+// Error returns a string representation of the field error.
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s at %s: %s", e.Type, e.Field, e.Message)
+}
+
+// FieldErrors returns the validation errors with their locations mapped onto the fields of the request
+// that was rejected, for errors returned by the client's methods.
+func (e *HTTPValidationError) FieldErrors() []FieldError {
+	errs := make([]FieldError, len(e.Detail))
+
+	for i, d := range e.Detail {
+		errs[i] = FieldError{Message: d.Message, Type: d.Type}
+
+		if field, ok := fieldPath(e.request, d.Location); ok {
+			errs[i].Field = field
+			errs[i].Resolved = true
+		} else {
+			loc := make([]string, len(d.Location))
+			for j, l := range d.Location {
+				loc[j] = fmt.Sprint(l)
+			}
+
+			errs[i].Field = strings.Join(loc, ".")
+		}
+	}
+
+	return errs
+}
+
+// withRequest records the request that a validation error in err is about, so that it can be mapped onto its fields.
+func withRequest(err error, in any) error {
+	var validation *HTTPValidationError
+	if errors.As(err, &validation) {
+		validation.request = in
+	}
+
+	return err
+}
+
+// fieldPath maps a location in the body of the request for in onto the Go path of the field it was encoded from.
+func fieldPath(in any, loc []any) (string, bool) {
+	v := reflect.ValueOf(in)
+	if !v.IsValid() || len(loc) == 0 || loc[0] != "body" {
+		return "", false
+	}
+
+	// a nil request is encoded as null, so none of its fields are in the body.
+	if v = reflect.Indirect(v); !v.IsValid() {
+		return "", false
+	}
+
+	var b strings.Builder
+
+	b.WriteString(v.Type().Name())
+
+	for _, seg := range loc[1:] {
+		// a workflow node is encoded as a header with its fields as settings.
+		if v.Kind() == reflect.Struct && reflect.PointerTo(v.Type()).Implements(nodeType) {
+			switch seg {
+			case "settings":
+				continue
+			case "id":
+				b.WriteString(".ID")
+				v = v.FieldByName("ID")
+
+				continue
+			case "name":
+				b.WriteString(".Name")
+				v = v.FieldByName("Name")
+
+				continue
+			}
+		}
+
+		switch seg := seg.(type) {
+		case string:
+			field, ok := jsonField(v, seg)
+			if !ok {
+				return "", false
+			}
+
+			b.WriteString("." + field.Name)
+			v = v.FieldByIndex(field.Index)
+
+		default:
+			i, ok := toInt(seg)
+			if v.Kind() != reflect.Slice || !ok || i < 0 || i >= v.Len() {
+				return "", false
+			}
+
+			b.WriteString("[" + strconv.Itoa(i) + "]")
+			v = v.Index(i)
+		}
+
+		// step into interfaces, naming the concrete type, and pointers.
+		if v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return "", false
+			}
+
+			v = v.Elem()
+
+			if v.Kind() == reflect.Pointer {
+				b.WriteString(".(*" + v.Type().Elem().Name() + ")")
+			} else {
+				b.WriteString(".(" + v.Type().Name() + ")")
+			}
+		}
+
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v = reflect.Zero(v.Type().Elem())
+			} else {
+				v = v.Elem()
+			}
+		}
+	}
+
+	return b.String(), true
+}
+
+var nodeType = reflect.TypeFor[WorkflowNode]()
+
+// jsonField finds the field of the struct v that is encoded under the given JSON name.
+// Fields without a JSON tag match the way encoding/json matches them, ignoring case, and also ignoring underscores,
+// since request types without tags are encoded by hand with snake case names.
+func jsonField(v reflect.Value, name string) (reflect.StructField, bool) {
+	if v.Kind() != reflect.Struct {
+		return reflect.StructField{}, false
+	}
+
+	var match reflect.StructField
+
+	found := false
+
+	for _, f := range reflect.VisibleFields(v.Type()) {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+
+		tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+
+		switch {
+		case tag == "-":
+			continue
+		case tag != "":
+			if tag == name {
+				return f, true
+			}
+		case !found && strings.EqualFold(f.Name, strings.ReplaceAll(name, "_", "")):
+			match, found = f, true
+		}
+	}
+
+	return match, found
+}
+
+func toInt(v any) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case float64:
+		return int(v), v == float64(int(v))
+	}
+
+	return 0, false
+}
@@ -0,0 +1,105 @@
+package unstructured
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFieldPath(t *testing.T) {
+	t.Parallel()
+
+	workflow := &CreateWorkflowRequest{
+		Name: "ingest",
+		WorkflowNodes: []WorkflowNode{
+			&PartitionerFast{Name: "Partitioner"},
+			&ChunkerTitle{Name: "Title"},
+			&ChunkerCharacter{Name: "Character"},
+		},
+	}
+
+	destination := CreateDestinationRequest{Name: "db", Config: &CouchbaseConnectorConfig{BatchSize: -1}}
+
+	for _, tt := range []struct {
+		in   any
+		loc  []any
+		want string
+	}{
+		{workflow, []any{"body", "name"}, "CreateWorkflowRequest.Name"},
+		{workflow, []any{"body", "workflow_nodes", 2.0, "settings", "max_characters"},
+			"CreateWorkflowRequest.WorkflowNodes[2].(*ChunkerCharacter).MaxCharacters"},
+		{workflow, []any{"body", "workflow_nodes", 1.0, "name"}, "CreateWorkflowRequest.WorkflowNodes[1].(*ChunkerTitle).Name"},
+		{workflow, []any{"body", "workflow_nodes", 1.0}, "CreateWorkflowRequest.WorkflowNodes[1].(*ChunkerTitle)"},
+		{destination, []any{"body", "config", "batch_size"}, "CreateDestinationRequest.Config.(*CouchbaseConnectorConfig).BatchSize"},
+		{destination, []any{"body", "name"}, "CreateDestinationRequest.Name"},
+		{UpdateWorkflowRequest{}, []any{"body", "schedule"}, "UpdateWorkflowRequest.Schedule"},
+		{&RunWorkflowRequest{}, []any{"body", "input_files"}, "RunWorkflowRequest.InputFiles"},
+	} {
+		if got, ok := fieldPath(tt.in, tt.loc); !ok || got != tt.want {
+			t.Errorf("fieldPath(%T, %v): expected %s, got %q (%t)", tt.in, tt.loc, tt.want, got, ok)
+		}
+	}
+
+	for _, tt := range []struct {
+		in  any
+		loc []any
+	}{
+		{nil, []any{"body", "name"}},
+		{(*CreateWorkflowRequest)(nil), []any{"body", "name"}},
+		{workflow, []any{"query", "name"}},
+		{workflow, []any{"body", "workflow_type"}},
+		{workflow, []any{"body", "workflow_nodes", 3.0, "settings"}},
+		{workflow, []any{"body", "workflow_nodes", 0.0, "type"}},
+		{destination, []any{"body", "config", "bogus"}},
+		{CreateDestinationRequest{}, []any{"body", "config", "batch_size"}},
+	} {
+		if got, ok := fieldPath(tt.in, tt.loc); ok {
+			t.Errorf("fieldPath(%T, %v): expected no field, got %s", tt.in, tt.loc, got)
+		}
+	}
+}
+
+func TestFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	client, mux := testclient(t)
+
+	mux.CreateWorkflow = func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"detail": [
+			{"loc": ["body", "workflow_nodes", 1, "settings", "max_characters"], "msg": "must be positive", "type": "value_error"},
+			{"loc": ["body", "workflow_type"], "msg": "invalid type", "type": "enum"}
+		]}`))
+	}
+
+	_, err := client.CreateWorkflow(testContext(t), &CreateWorkflowRequest{
+		Name:          "ingest",
+		WorkflowNodes: []WorkflowNode{&PartitionerFast{}, &ChunkerCharacter{MaxCharacters: -1}},
+	})
+
+	var validation *HTTPValidationError
+	if !errors.As(err, &validation) {
+		t.Fatalf("expected error to be an %T, got %v", validation, err)
+	}
+
+	fields := validation.FieldErrors()
+	if len(fields) != 2 {
+		t.Fatalf("expected 2 field errors, got %d", len(fields))
+	}
+
+	if err := errors.Join(
+		eq("field", fields[0], FieldError{
+			Field:    "CreateWorkflowRequest.WorkflowNodes[1].(*ChunkerCharacter).MaxCharacters",
+			Resolved: true,
+			Message:  "must be positive",
+			Type:     "value_error",
+		}),
+		eq("unresolved field", fields[1], FieldError{Field: "body.workflow_type", Message: "invalid type", Type: "enum"}),
+		eq("message", strings.Contains(err.Error(),
+			"value_error at CreateWorkflowRequest.WorkflowNodes[1].(*ChunkerCharacter).MaxCharacters: must be positive"), true),
+	); err != nil {
+		t.Error(err)
+	}
+}
@@ -18,8 +18,13 @@ type CreateWorkflowRequest struct {
 	ReprocessAll  *bool          `json:"reprocess_all,omitempty"`
 }
 
-// CreateWorkflow creates a new workflow
+// CreateWorkflow creates a new workflow.
+// The schedule, if set, must be one of the presets accepted by the API, see [ParseSchedule].
 func (c *Client) CreateWorkflow(ctx context.Context, in *CreateWorkflowRequest) (*Workflow, error) {
+	if err := validateScheduleRequest(in.Schedule); err != nil {
+		return nil, err
+	}
+
 	body, err := json.Marshal(struct {
 		*CreateWorkflowRequest
 		WorkflowType WorkflowType `json:"workflow_type"`
@@ -44,7 +49,7 @@ func (c *Client) CreateWorkflow(ctx context.Context, in *CreateWorkflowRequest)
 
 	var workflow Workflow
 	if err := c.do(req, &workflow); err != nil {
-		return nil, fmt.Errorf("failed to create workflow: %w", err)
+		return nil, fmt.Errorf("failed to create workflow: %w", withRequest(err, in))
 	}
 
 	return &workflow, nil
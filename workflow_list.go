@@ -49,6 +49,31 @@ func (c *Client) ListWorkflows(ctx context.Context, in *ListWorkflowsRequest) ([
 	return workflows, nil
 }
 
+// listAllWorkflows lists every workflow matching the filters of in, following pages until one comes back short.
+// The Page and PageSize of in are ignored.
+func (c *Client) listAllWorkflows(ctx context.Context, in ListWorkflowsRequest) ([]Workflow, error) {
+	const pageSize = 20
+
+	var all []Workflow
+
+	in.PageSize = Int(pageSize)
+
+	for page := 1; ; page++ {
+		in.Page = Int(page)
+
+		workflows, err := c.ListWorkflows(ctx, &in)
+		if err != nil {
+			return all, err
+		}
+
+		all = append(all, workflows...)
+
+		if len(workflows) < pageSize {
+			return all, nil
+		}
+	}
+}
+
 // buildWorkflowListQuery builds the query parameters for the workflow list request.
 func buildWorkflowListQuery(in *ListWorkflowsRequest) url.Values {
 	q := make(url.Values)
@@ -16,7 +16,7 @@ func (w WorkflowNodes) ValidateNodeOrder() (err error) {
 	}
 
 	// you have to partition.
-	switch w[0].(type) {
+	switch pointerNode(w[0]).(type) {
 	case *PartitionerAuto, *PartitionerVLM, *PartitionerHiRes, *PartitionerFast:
 		// good
 	default:
@@ -32,7 +32,7 @@ func (w WorkflowNodes) ValidateNodeOrder() (err error) {
 	)
 
 	for i, node := range w[1:] {
-		switch node := node.(type) {
+		switch node := pointerNode(node).(type) {
 		case *PartitionerAuto, *PartitionerVLM, *PartitionerHiRes, *PartitionerFast:
 			err = errors.Join(err, errors.New("only the first node may be a partitioner"))
 
@@ -94,6 +94,54 @@ func (w WorkflowNodes) ValidateNodeOrder() (err error) {
 	return err
 }
 
+// Validate validates the order of the nodes, see [WorkflowNodes.ValidateNodeOrder],
+// and the models and subtypes the nodes use.
+func (w WorkflowNodes) Validate() error {
+	err := w.ValidateNodeOrder()
+
+	for i, node := range w {
+		var nodeErr error
+
+		switch node := pointerNode(node).(type) {
+		case *PartitionerAuto:
+			nodeErr = node.ValidateModel()
+		case *PartitionerVLM:
+			nodeErr = node.ValidateModel()
+		case *Enricher:
+			nodeErr = node.ValidateSubtype()
+		case *Embedder:
+			nodeErr = node.ValidateModel()
+		}
+
+		if nodeErr != nil {
+			err = errors.Join(err, fmt.Errorf("node %d: %w", i, nodeErr))
+		}
+	}
+
+	return err
+}
+
+// pointerNode returns a pointer to a copy of the node if it is a value,
+// so that nodes given as values, like Enricher{}, are handled like those given as pointers.
+func pointerNode(node WorkflowNode) WorkflowNode {
+	switch node := node.(type) {
+	case ChunkerCharacter:
+		return &node
+	case ChunkerTitle:
+		return &node
+	case ChunkerPage:
+		return &node
+	case ChunkerSimilarity:
+		return &node
+	case Embedder:
+		return &node
+	case Enricher:
+		return &node
+	}
+
+	return node
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 func (w WorkflowNodes) MarshalJSON() ([]byte, error) {
 	nodes := make([]json.RawMessage, len(w))
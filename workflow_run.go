@@ -58,7 +58,7 @@ func (c *Client) RunWorkflow(ctx context.Context, in *RunWorkflowRequest) (*Job,
 
 	var job Job
 	if err := c.do(req, &job); err != nil {
-		return nil, fmt.Errorf("failed to run workflow: %w", err)
+		return nil, fmt.Errorf("failed to run workflow: %w", withRequest(err, in))
 	}
 
 	return &job, nil
@@ -89,7 +89,11 @@ func addfiles(req *http.Request, files []File) error {
 		return fmt.Errorf("failed to close multipart writer: %w", err)
 	}
 
-	req.Body = io.NopCloser(&buf)
+	data := buf.Bytes()
+
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	req.ContentLength = int64(len(data))
+	req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(data)), nil }
 
 	// Set the content type header for multipart form data
 	req.Header.Set("Content-Type", writer.FormDataContentType())
@@ -22,7 +22,12 @@ type UpdateWorkflowRequest struct {
 
 // UpdateWorkflow updates the configuration of an existing workflow.
 // It returns the updated workflow.
+// The schedule, if set, must be one of the presets accepted by the API, see [ParseSchedule].
 func (c *Client) UpdateWorkflow(ctx context.Context, in UpdateWorkflowRequest) (*Workflow, error) {
+	if err := validateScheduleRequest(in.Schedule); err != nil {
+		return nil, err
+	}
+
 	body, err := json.Marshal(in)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal workflow update request: %w", err)
@@ -41,7 +46,7 @@ func (c *Client) UpdateWorkflow(ctx context.Context, in UpdateWorkflowRequest) (
 
 	var workflow Workflow
 	if err := c.do(req, &workflow); err != nil {
-		return nil, fmt.Errorf("failed to update workflow: %w", err)
+		return nil, fmt.Errorf("failed to update workflow: %w", withRequest(err, in))
 	}
 
 	return &workflow, nil